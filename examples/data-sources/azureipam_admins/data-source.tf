@@ -0,0 +1,6 @@
+# Return all the IPAM administrators
+data "azureipam_admins" "all" {
+}
+output "all_admins" {
+  value = data.azureipam_admins.all
+}
@@ -0,0 +1,7 @@
+# Return the Azure endpoints discovered by IPAM in a resource group
+data "azureipam_azure_endpoints" "filtered" {
+  resource_group = "rg-app-prod"
+}
+output "filtered_endpoints" {
+  value = data.azureipam_azure_endpoints.filtered
+}
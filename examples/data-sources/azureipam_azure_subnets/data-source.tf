@@ -0,0 +1,8 @@
+# Return the Azure subnets discovered by IPAM in a range
+data "azureipam_azure_subnets" "filtered" {
+  subscription_id = "6ba4c0d7-1e2f-4a3b-9c4d-5e6f7a8b9c0d"
+  prefix          = "10.82.0.0/24"
+}
+output "filtered_subnets" {
+  value = data.azureipam_azure_subnets.filtered
+}
@@ -0,0 +1,16 @@
+# Return all the Azure virtual networks discovered by IPAM in a subscription
+data "azureipam_azure_vnets" "all" {
+  subscription_id = "6ba4c0d7-1e2f-4a3b-9c4d-5e6f7a8b9c0d"
+}
+output "all_vnets" {
+  value = data.azureipam_azure_vnets.all
+}
+
+# Return the Azure virtual networks of a resource group with any prefix in a range
+data "azureipam_azure_vnets" "filtered" {
+  resource_group = "rg-network-prod"
+  prefix         = "10.82.0.0/16"
+}
+output "filtered_vnets" {
+  value = data.azureipam_azure_vnets.filtered
+}
@@ -0,0 +1,6 @@
+# Return all the subscriptions excluded from the IPAM discovery
+data "azureipam_exclusions" "all" {
+}
+output "all_exclusions" {
+  value = data.azureipam_exclusions.all
+}
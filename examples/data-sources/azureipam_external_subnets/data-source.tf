@@ -0,0 +1,9 @@
+# Return all subnets of an external network in a space/block
+data "azureipam_external_subnets" "all" {
+  space    = "au"
+  block    = "AustraliaSoutheast"
+  external = "acctest"
+}
+output "all_external_subnets" {
+  value = data.azureipam_external_subnets.all
+}
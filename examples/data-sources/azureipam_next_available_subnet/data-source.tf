@@ -0,0 +1,10 @@
+# Returns the next available cidr for a new subnet in a virtual network tracked by IPAM
+data "azureipam_next_available_subnet" "example" {
+  vnet_id        = "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/RG-WE-ALL-COMMS-01/providers/Microsoft.Network/virtualNetworks/vnet-we-d-terratest-hub-01"
+  size           = 26
+  reverse_search = false
+  smallest_cidr  = true
+}
+output "next_available_subnet" {
+  value = data.azureipam_next_available_subnet.example
+}
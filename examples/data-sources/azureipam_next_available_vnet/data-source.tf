@@ -0,0 +1,11 @@
+# Returns the cidr that a reservation of the requested size would receive, without reserving it
+data "azureipam_next_available_vnet" "example" {
+  space          = "au"
+  blocks         = ["AustraliaEast", "AustraliaSoutheast"]
+  size           = 24
+  reverse_search = false
+  smallest_cidr  = true
+}
+output "next_available_vnet" {
+  value = data.azureipam_next_available_vnet.example
+}
@@ -0,0 +1,12 @@
+# Splits the range of a reservation into the subnets of the virtual network
+data "azureipam_subnet_plan" "example" {
+  cidr = azureipam_reservation.new.cidr
+  subnets = [
+    { name = "GatewaySubnet", prefix_length = 27 },
+    { name = "app", prefix_length = 24 },
+    { name = "data", prefix_length = 26 },
+  ]
+}
+output "subnet_plan" {
+  value = data.azureipam_subnet_plan.example.cidrs
+}
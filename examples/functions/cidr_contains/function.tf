@@ -0,0 +1,4 @@
+# Checks that the subnet is in the reserved range
+output "subnet_in_reservation" {
+  value = provider::azureipam::cidr_contains(azureipam_reservation.new.cidr, "10.82.4.64/26")
+}
@@ -0,0 +1,4 @@
+# Returns the number of host addresses of the reserved range
+output "reservation_hosts" {
+  value = provider::azureipam::cidr_host_count(azureipam_reservation.new.cidr)
+}
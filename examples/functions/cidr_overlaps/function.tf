@@ -0,0 +1,4 @@
+# Checks that the reserved range doesn't overlap the on-premises network
+output "overlaps_on_premises" {
+  value = provider::azureipam::cidr_overlaps(azureipam_reservation.new.cidr, "192.168.0.0/16")
+}
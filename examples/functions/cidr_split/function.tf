@@ -0,0 +1,12 @@
+# Splits the reserved range into the subnets of the virtual network
+locals {
+  subnets = provider::azureipam::cidr_split(azureipam_reservation.new.cidr, {
+    web     = 25
+    app     = 26
+    data    = 27
+    gateway = 27
+  })
+}
+output "app_subnet" {
+  value = local.subnets["app"]
+}
@@ -15,14 +15,11 @@ locals {
   ipam_apiId = "d47d5cd9-b599-4a6a-9d54-254565ff08de" #ApplicationId of the Engine Azure AD Application, see also the [IPAM deployment documentation](https://github.com/Azure/ipam/tree/main/docs/deployment)
 }
 
-## Get an access token for ipam engine application
-data "external" "get_access_token" {
-  program = ["az", "account", "get-access-token", "--resource", "api://${local.ipam_apiId}", "--query", "{accessToken:accessToken}"]
-}
-
-# Configure the Azure IPAM provider
+# Configure the Azure IPAM provider, authenticating with a service principal.
+# The client secret is read from the AZUREIPAM_CLIENT_SECRET environment variable.
 provider "azureipam" {
-  api_url                = local.ipam_url
-  token                  = data.external.get_access_token.result.accessToken
-  skip_cert_verification = true //ONLY recommended for development environments
+  api_url       = local.ipam_url
+  engine_app_id = local.ipam_apiId
+  tenant_id     = "00000000-0000-0000-0000-000000000000"
+  client_id     = "11111111-1111-1111-1111-111111111111"
 }
@@ -0,0 +1,20 @@
+# Add a user as IPAM administrator
+resource "azureipam_admin" "user" {
+  object_id = "5d2b8a4e-7c1f-4e3a-9b6d-2f8c0a1e3b5d"
+  type      = "User"
+  name      = "Jane Doe"
+  email     = "jane.doe@contoso.com"
+}
+output "admin_user" {
+  value = azureipam_admin.user
+}
+
+# Add a service principal as IPAM administrator
+resource "azureipam_admin" "principal" {
+  object_id = "a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d"
+  type      = "Principal"
+  name      = "sp-terraform-ipam"
+}
+output "admin_principal" {
+  value = azureipam_admin.principal
+}
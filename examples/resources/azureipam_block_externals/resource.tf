@@ -0,0 +1,20 @@
+# Declare the full list of external networks of a block
+resource "azureipam_block_externals" "onprem" {
+  space = "au"
+  block = "AustraliaEast"
+  externals = [
+    {
+      name        = "datacenter01"
+      description = "On-premises datacenter 01"
+      cidr        = "10.90.0.0/16"
+    },
+    {
+      name        = "datacenter02"
+      description = "On-premises datacenter 02"
+      cidr        = "10.91.0.0/16"
+    },
+  ]
+}
+output "block_externals" {
+  value = azureipam_block_externals.onprem
+}
@@ -0,0 +1,12 @@
+# Associate the full set of networks of a block
+resource "azureipam_block_networks" "all" {
+  space = "au"
+  block = "AustraliaEast"
+  ids = [
+    "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/RG-WE-ALL-COMMS-01/providers/Microsoft.Network/virtualNetworks/vnet-we-d-terratest-hub-01",
+    "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/RG-WE-ALL-COMMS-01/providers/Microsoft.Network/virtualHubs/vhub-we-p-01",
+  ]
+}
+output "block_networks" {
+  value = azureipam_block_networks.all
+}
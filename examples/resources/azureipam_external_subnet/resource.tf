@@ -0,0 +1,12 @@
+# Create a new subnet in the acctest external network of ua/AustraliaSoutheast block
+resource "azureipam_external_subnet" "new" {
+  space       = "au"
+  block       = "AustraliaSoutheast"
+  external    = "acctest"
+  name        = "frontend"
+  description = "Frontend subnet of the external network"
+  cidr        = "10.83.6.0/26"
+}
+output "external_subnet" {
+  value = azureipam_external_subnet.new
+}
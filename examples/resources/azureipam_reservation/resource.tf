@@ -21,9 +21,24 @@ resource "azureipam_reservation" "new" {
   ]
   size           = 24
   description    = "this is a test"
+  user_tags = {
+    owner         = "network-team"
+    "cost-center" = "1234"
+  }
   reverse_search = true
   smallest_cidr  = true
 }
 output "created" {
   value = azureipam_reservation.new
+}
+
+# Create a reservation of a specific CIDR in a block
+resource "azureipam_reservation" "specific" {
+  space       = "au"
+  blocks      = ["AustraliaEast"]
+  cidr        = "10.82.4.0/24"
+  description = "this is a test"
+}
+output "created_specific" {
+  value = azureipam_reservation.specific
 }
\ No newline at end of file
@@ -18,6 +18,10 @@ resource "azureipam_reservation_cidr" "new" {
   block         = "AustraliaEast"
   specific_cidr = "10.82.4.0/24"
   description   = "this is a test"
+  user_tags = {
+    owner         = "network-team"
+    "cost-center" = "1234"
+  }
 }
 output "created" {
   value = azureipam_reservation_cidr.new
@@ -0,0 +1,38 @@
+# Create a CIDR reservation
+resource "azureipam_reservation" "new" {
+  space       = "au"
+  blocks      = ["AustraliaEast"]
+  size        = 24
+  description = "this is a test"
+}
+
+# Deploy the azurerm vnet
+resource "azurerm_resource_group" "example" {
+  name     = "example-resources"
+  location = "Australia East"
+}
+resource "azurerm_virtual_network" "example" {
+  name                = "example-network"
+  location            = azurerm_resource_group.example.location
+  resource_group_name = azurerm_resource_group.example.name
+
+  address_space = [azureipam_reservation.new.cidr]
+  tags          = azureipam_reservation.new.tags ##Don't forget to add the auto-generated `X-IPAM-RES-ID` tag to the vnet.
+}
+
+# Wait until the IPAM application settles the reservation with the vnet
+resource "azureipam_reservation_settlement" "new" {
+  space         = azureipam_reservation.new.space
+  block         = azureipam_reservation.new.block
+  id            = azureipam_reservation.new.id
+  poll_interval = 30
+
+  timeouts {
+    create = "30m"
+  }
+
+  depends_on = [azurerm_virtual_network.example]
+}
+output "settled" {
+  value = azureipam_reservation_settlement.new
+}
@@ -0,0 +1,285 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	ipamclient "terraform-provider-azureipam/ipamclient"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &adminResource{}
+	_ resource.ResourceWithConfigure      = &adminResource{}
+	_ resource.ResourceWithImportState    = &adminResource{}
+	_ resource.ResourceWithValidateConfig = &adminResource{}
+)
+
+// NewAdminResource is a helper function to simplify the provider implementation.
+func NewAdminResource() resource.Resource {
+	return &adminResource{}
+}
+
+// adminResourceModel maps the resource schema data.
+type adminResourceModel struct {
+	ObjectId types.String   `tfsdk:"object_id"`
+	Type     types.String   `tfsdk:"type"`
+	Name     types.String   `tfsdk:"name"`
+	Email    types.String   `tfsdk:"email"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+// adminResource is the resource implementation.
+type adminResource struct {
+	client *ipamclient.Client
+}
+
+// Metadata returns the resource type name.
+func (r *adminResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_admin"
+}
+
+// Schema defines the schema for the resource.
+func (r *adminResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "The admin resource allows you to add a user or service principal as administrator of the IPAM application.",
+		Attributes: map[string]schema.Attribute{
+			"object_id": schema.StringAttribute{
+				Description: "Object id in Microsoft Entra ID of the user or service principal. Changing this forces a new resource to be created.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(uuidRegex, "must be an object id, e.g. 00000000-0000-0000-0000-000000000000"),
+				},
+			},
+			"type": schema.StringAttribute{
+				Description: "Type of the administrator, `User` or `Principal` for service principals. Changing this forces a new resource to be created.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(ipamclient.AdminTypeUser, ipamclient.AdminTypePrincipal),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Display name of the user or service principal. Changing this forces a new resource to be created.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"email": schema.StringAttribute{
+				Description: "Email of the user, only allowed when `type` is `User`. Changing this forces a new resource to be created.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// ValidateConfig validates that the email is only configured for users.
+func (r *adminResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config adminResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Type.ValueString() == ipamclient.AdminTypePrincipal && !config.Email.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("email"),
+			"Invalid Attribute Combination",
+			"The email can only be configured for administrators of type "+ipamclient.AdminTypeUser+".",
+		)
+	}
+}
+
+// Create a new resource.
+func (r *adminResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan adminResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	admin, err := r.client.CreateAdmin(ctx,
+		plan.Type.ValueString(),
+		plan.ObjectId.ValueString(),
+		plan.Name.ValueString(),
+		plan.Email.ValueStringPointer(),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating admin",
+			"Could not create admin, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	flattenAdmin(admin, &plan)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read resource information.
+func (r *adminResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state adminResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	//read admin
+	admin, err := r.client.GetAdmin(ctx,
+		state.ObjectId.ValueString(),
+	)
+	if ipamclient.IsNotFound(err) {
+		//deleted outside terraform, remove from state to plan its recreation
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading AzureIpam Admin",
+			"Could not read AzureIpam Admin with object id "+state.ObjectId.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Overwrite items with refreshed state
+	flattenAdmin(admin, &state)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update not allowed, returning readed plan as current state.
+func (n *adminResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var model adminResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *adminResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state adminResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Delete existing admin
+	err := r.client.DeleteAdmin(ctx,
+		state.ObjectId.ValueString(),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting AzureIpam Admin",
+			"Could not delete admin, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *adminResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ipamclient.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *azureipam.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *adminResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to object_id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("object_id"), req, resp)
+}
+
+func flattenAdmin(admin *ipamclient.Admin, model *adminResourceModel) {
+	//the object ids are case insensitive, so the configured casing is kept to prevent a false replacement
+	if !strings.EqualFold(model.ObjectId.ValueString(), admin.Id) {
+		model.ObjectId = types.StringValue(admin.Id)
+	}
+	model.Type = types.StringValue(admin.Type)
+	model.Name = types.StringValue(admin.Name)
+	model.Email = types.StringPointerValue(admin.Email)
+}
@@ -0,0 +1,165 @@
+package provider
+
+import (
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/jarcoal/httpmock"
+)
+
+func TestAccAdminResource(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	created := false
+	httpmock.RegisterMatcherResponder("POST", "https://mockedHost.azurewebsites.net/api/admin/admins",
+		httpmock.BodyContainsString(`"id":"a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d"`),
+		func(req *http.Request) (*http.Response, error) {
+			created = true
+			return httpmock.NewStringResponse(http.StatusCreated, httpmock.File("tests/resource/admin/admins_with_new_admin.json").String()), nil
+		})
+	httpmock.RegisterResponder("GET", "https://mockedHost.azurewebsites.net/api/admin/admins",
+		func(req *http.Request) (*http.Response, error) {
+			if !created {
+				return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/resource/admin/admins_without_new_admin.json").String()), nil
+			}
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/resource/admin/admins_with_new_admin.json").String()), nil
+		})
+	httpmock.RegisterResponder("DELETE", "https://mockedHost.azurewebsites.net/api/admin/admins/a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d",
+		func(req *http.Request) (*http.Response, error) {
+			created = false
+			return httpmock.NewStringResponse(http.StatusOK, ""), nil
+		})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccProviderConfig + `resource "azureipam_admin" "test" {
+					object_id = "a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d"
+					type      = "Principal"
+					name      = "sp-terraform-ipam"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					//Verify common attributes to ensure that all are set
+					resource.TestCheckResourceAttr("azureipam_admin.test", "object_id", "a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d"),
+					resource.TestCheckResourceAttr("azureipam_admin.test", "type", "Principal"),
+					resource.TestCheckResourceAttr("azureipam_admin.test", "name", "sp-terraform-ipam"),
+					resource.TestCheckNoResourceAttr("azureipam_admin.test", "email"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         "azureipam_admin.test",
+				ImportState:                          true,
+				ImportStateId:                        "a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "object_id",
+			},
+			// Update  NOT ALLOWED by provider
+
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccAdminResourceDrift(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	admins := "tests/resource/admin/admins_with_new_admin.json"
+	httpmock.RegisterResponder("POST", "https://mockedHost.azurewebsites.net/api/admin/admins",
+		func(req *http.Request) (*http.Response, error) {
+			admins = "tests/resource/admin/admins_with_new_admin.json"
+			return httpmock.NewStringResponse(http.StatusCreated, httpmock.File(admins).String()), nil
+		})
+	httpmock.RegisterResponder("GET", "https://mockedHost.azurewebsites.net/api/admin/admins",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File(admins).String()), nil
+		})
+	httpmock.RegisterResponder("DELETE", "https://mockedHost.azurewebsites.net/api/admin/admins/a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, ""), nil
+		})
+
+	config := testAccProviderConfig + `resource "azureipam_admin" "test" {
+		object_id = "a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d"
+		type      = "Principal"
+		name      = "sp-terraform-ipam"
+	}`
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: config,
+				Check:  resource.TestCheckResourceAttr("azureipam_admin.test", "name", "sp-terraform-ipam"),
+			},
+			// Modified outside terraform, replacement must be planned
+			{
+				PreConfig:          func() { admins = "tests/resource/admin/admins_with_renamed_admin.json" },
+				Config:             config,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			// Deleted outside terraform, recreation must be planned
+			{
+				PreConfig:          func() { admins = "tests/resource/admin/admins_without_new_admin.json" },
+				Config:             config,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAdminResourceObjectIdCasing(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	//the engine returns the object id in lowercase, whatever the casing used to create the admin
+	httpmock.RegisterResponder("POST", "https://mockedHost.azurewebsites.net/api/admin/admins",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusCreated, httpmock.File("tests/resource/admin/admins_with_new_admin.json").String()), nil
+		})
+	httpmock.RegisterResponder("GET", "https://mockedHost.azurewebsites.net/api/admin/admins",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/resource/admin/admins_with_new_admin.json").String()), nil
+		})
+	httpmock.RegisterResponder("DELETE", "https://mockedHost.azurewebsites.net/api/admin/admins/A1B2C3D4-E5F6-4A7B-8C9D-0E1F2A3B4C5D",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, ""), nil
+		})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing, the configured casing must be kept to prevent a replacement
+			{
+				Config: testAccProviderConfig + `resource "azureipam_admin" "test" {
+					object_id = "A1B2C3D4-E5F6-4A7B-8C9D-0E1F2A3B4C5D"
+					type      = "Principal"
+					name      = "sp-terraform-ipam"
+				}`,
+				Check: resource.TestCheckResourceAttr("azureipam_admin.test", "object_id", "A1B2C3D4-E5F6-4A7B-8C9D-0E1F2A3B4C5D"),
+			},
+		},
+	})
+}
+
+func TestAccAdminResourcePrincipalWithEmail(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig + `resource "azureipam_admin" "test" {
+					object_id = "a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d"
+					type      = "Principal"
+					name      = "sp-terraform-ipam"
+					email     = "sp-terraform-ipam@contoso.com"
+				}`,
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+		},
+	})
+}
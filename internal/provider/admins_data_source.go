@@ -0,0 +1,117 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	ipamclient "terraform-provider-azureipam/ipamclient"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &adminsDataSource{}
+	_ datasource.DataSourceWithConfigure = &adminsDataSource{}
+)
+
+// NewAdminsDataSource is a helper function to simplify the provider implementation.
+func NewAdminsDataSource() datasource.DataSource {
+	return &adminsDataSource{}
+}
+
+// adminsDataSource is the data source implementation.
+type adminsDataSource struct {
+	client *ipamclient.Client
+}
+
+// adminsDataSourceModel maps the data source schema data.
+type adminsDataSourceModel struct {
+	Admins []adminModel `tfsdk:"admins"`
+}
+
+// Metadata returns the data source type name.
+func (d *adminsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_admins"
+}
+
+// Schema defines the schema for the data source.
+func (d *adminsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "The admins data source allows you to retrieve information about all the administrators of the IPAM application.",
+		Attributes: map[string]schema.Attribute{
+			"admins": schema.ListNestedAttribute{
+				Description: "List containing the `admins` found.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"object_id": schema.StringAttribute{
+							Description: "Object id in Microsoft Entra ID of the user or service principal.",
+							Computed:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "Type of the administrator, `User` or `Principal` for service principals.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "Display name of the user or service principal.",
+							Computed:    true,
+						},
+						"email": schema.StringAttribute{
+							Description: "Email of the user, only set for administrators of type `User`.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *adminsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state adminsDataSourceModel
+
+	admins, err := d.client.GetAdmins(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read AzureIpam Admins",
+			err.Error(),
+		)
+		return
+	}
+
+	// Map response body to model
+	state.Admins = []adminModel{}
+	for _, admin := range *admins {
+		state.Admins = append(state.Admins, flattenAdminInfo(&admin))
+	}
+
+	// Set state
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *adminsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ipamclient.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *azureipam.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
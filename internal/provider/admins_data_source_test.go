@@ -0,0 +1,44 @@
+package provider
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/jarcoal/httpmock"
+)
+
+func TestAccAdminsDataSource(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("GET", "https://mockedHost.azurewebsites.net/api/admin/admins",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/datasource/admins/admins_all.json").String()), nil
+		})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccProviderConfig + `data "azureipam_admins" "test" {
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify number of admins returned
+					resource.TestCheckResourceAttr("data.azureipam_admins.test", "admins.#", "2"),
+					// Verify the user admin to ensure all attributes are set
+					resource.TestCheckResourceAttr("data.azureipam_admins.test", "admins.0.object_id", "5d2b8a4e-7c1f-4e3a-9b6d-2f8c0a1e3b5d"),
+					resource.TestCheckResourceAttr("data.azureipam_admins.test", "admins.0.type", "User"),
+					resource.TestCheckResourceAttr("data.azureipam_admins.test", "admins.0.name", "Jane Doe"),
+					resource.TestCheckResourceAttr("data.azureipam_admins.test", "admins.0.email", "jane.doe@contoso.com"),
+
+					// Verify the service principal admin, without email
+					resource.TestCheckResourceAttr("data.azureipam_admins.test", "admins.1.object_id", "a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d"),
+					resource.TestCheckResourceAttr("data.azureipam_admins.test", "admins.1.type", "Principal"),
+					resource.TestCheckResourceAttr("data.azureipam_admins.test", "admins.1.name", "sp-terraform-ipam"),
+					resource.TestCheckNoResourceAttr("data.azureipam_admins.test", "admins.1.email"),
+				),
+			},
+		},
+	})
+}
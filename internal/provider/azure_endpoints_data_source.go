@@ -0,0 +1,192 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	ipamclient "terraform-provider-azureipam/ipamclient"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &azureEndpointsDataSource{}
+	_ datasource.DataSourceWithConfigure = &azureEndpointsDataSource{}
+)
+
+// NewAzureEndpointsDataSource is a helper function to simplify the provider implementation.
+func NewAzureEndpointsDataSource() datasource.DataSource {
+	return &azureEndpointsDataSource{}
+}
+
+// azureEndpointsDataSource is the data source implementation.
+type azureEndpointsDataSource struct {
+	client *ipamclient.Client
+}
+
+// azureEndpointsDataSourceModel maps the data source schema data.
+type azureEndpointsDataSourceModel struct {
+	SubscriptionId types.String         `tfsdk:"subscription_id"`
+	ResourceGroup  types.String         `tfsdk:"resource_group"`
+	Prefix         types.String         `tfsdk:"prefix"`
+	Endpoints      []azureEndpointModel `tfsdk:"endpoints"`
+}
+
+// Metadata returns the data source type name.
+func (d *azureEndpointsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_azure_endpoints"
+}
+
+// Schema defines the schema for the data source.
+func (d *azureEndpointsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "The azure endpoints data source allows you to retrieve the Azure endpoints, as network interfaces and private endpoints, discovered by the IPAM application.",
+		Attributes: map[string]schema.Attribute{
+			"subscription_id": schema.StringAttribute{
+				Description: "Id of the subscription for which to filter the `endpoints`.",
+				Optional:    true,
+			},
+			"resource_group": schema.StringAttribute{
+				Description: "Name of the resource group for which to filter the `endpoints`.",
+				Optional:    true,
+			},
+			"prefix": schema.StringAttribute{
+				Description: "IPV4 range, in cidr notation, for which to filter the `endpoints` with the private IP contained in it.",
+				Optional:    true,
+				Validators: []validator.String{
+					ipv4CidrValidator(minPrefixLength, maxExternalPrefixLength),
+				},
+			},
+			"endpoints": schema.ListNestedAttribute{
+				Description: "List containing the `endpoints` found.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "Name of the endpoint.",
+							Computed:    true,
+						},
+						"id": schema.StringAttribute{
+							Description: "Resource Id of the endpoint.",
+							Computed:    true,
+						},
+						"private_ip": schema.StringAttribute{
+							Description: "Private IP address assigned to the endpoint.",
+							Computed:    true,
+						},
+						"vnet_name": schema.StringAttribute{
+							Description: "Name of the virtual network of the endpoint.",
+							Computed:    true,
+						},
+						"vnet_id": schema.StringAttribute{
+							Description: "Resource Id of the virtual network of the endpoint.",
+							Computed:    true,
+						},
+						"subnet_name": schema.StringAttribute{
+							Description: "Name of the subnet of the endpoint.",
+							Computed:    true,
+						},
+						"subnet_id": schema.StringAttribute{
+							Description: "Resource Id of the subnet of the endpoint.",
+							Computed:    true,
+						},
+						"resource_group": schema.StringAttribute{
+							Description: "Name of the resource group where the endpoint is deployed.",
+							Computed:    true,
+						},
+						"subscription_id": schema.StringAttribute{
+							Description: "Id of the subscription where the endpoint is deployed.",
+							Computed:    true,
+						},
+						"tenant_id": schema.StringAttribute{
+							Description: "Id of the tenant where the endpoint is deployed.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *azureEndpointsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state azureEndpointsDataSourceModel
+
+	// Read Terraform configuration state into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	filter := azureInventoryFilter{
+		SubscriptionId: state.SubscriptionId,
+		ResourceGroup:  state.ResourceGroup,
+		Prefix:         state.Prefix,
+	}
+
+	endpoints, err := d.client.GetAzureEndpoints(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read AzureIpam Azure Endpoints",
+			err.Error(),
+		)
+		return
+	}
+
+	// Map response body to model
+	state.Endpoints = []azureEndpointModel{}
+	for _, endpoint := range *endpoints {
+		matches, err := filter.matches(endpoint.SubscriptionId, endpoint.ResourceGroup, endpointRanges(endpoint))
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("prefix"),
+				"Invalid Prefix Filter",
+				err.Error(),
+			)
+			return
+		}
+		if matches {
+			state.Endpoints = append(state.Endpoints, flattenAzureEndpoint(&endpoint))
+		}
+	}
+
+	// Set state
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *azureEndpointsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ipamclient.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *azureipam.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// endpointRanges returns the private IP of the endpoint as the list of ranges to filter, empty if it has no private IP.
+func endpointRanges(endpoint ipamclient.AzureEndpoint) []string {
+	if endpoint.PrivateIp == nil {
+		return nil
+	}
+	return []string{*endpoint.PrivateIp}
+}
@@ -0,0 +1,63 @@
+package provider
+
+import (
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/jarcoal/httpmock"
+)
+
+func TestAccAzureEndpointsDataSource(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("GET", "https://mockedHost.azurewebsites.net/api/azure/endpoint",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/datasource/azure_endpoints/azure_endpoints.json").String()), nil
+		})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing without filters
+			{
+				Config: testAccProviderConfig + `data "azureipam_azure_endpoints" "test" {
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify number of endpoints returned
+					resource.TestCheckResourceAttr("data.azureipam_azure_endpoints.test", "endpoints.#", "2"),
+					// Verify the first endpoint to ensure all attributes are set
+					resource.TestCheckResourceAttr("data.azureipam_azure_endpoints.test", "endpoints.0.name", "pe-storage-prod"),
+					resource.TestCheckResourceAttr("data.azureipam_azure_endpoints.test", "endpoints.0.id", "/subscriptions/6ba4c0d7-1e2f-4a3b-9c4d-5e6f7a8b9c0d/resourceGroups/rg-app-prod/providers/Microsoft.Network/privateEndpoints/pe-storage-prod"),
+					resource.TestCheckResourceAttr("data.azureipam_azure_endpoints.test", "endpoints.0.private_ip", "10.82.0.10"),
+					resource.TestCheckResourceAttr("data.azureipam_azure_endpoints.test", "endpoints.0.vnet_name", "vnet-prod-aue-01"),
+					resource.TestCheckResourceAttr("data.azureipam_azure_endpoints.test", "endpoints.0.vnet_id", "/subscriptions/6ba4c0d7-1e2f-4a3b-9c4d-5e6f7a8b9c0d/resourceGroups/rg-network-prod/providers/Microsoft.Network/virtualNetworks/vnet-prod-aue-01"),
+					resource.TestCheckResourceAttr("data.azureipam_azure_endpoints.test", "endpoints.0.subnet_name", "snet-frontend"),
+					resource.TestCheckResourceAttr("data.azureipam_azure_endpoints.test", "endpoints.0.subnet_id", "/subscriptions/6ba4c0d7-1e2f-4a3b-9c4d-5e6f7a8b9c0d/resourceGroups/rg-network-prod/providers/Microsoft.Network/virtualNetworks/vnet-prod-aue-01/subnets/snet-frontend"),
+					resource.TestCheckResourceAttr("data.azureipam_azure_endpoints.test", "endpoints.0.resource_group", "RG-APP-PROD"),
+					resource.TestCheckResourceAttr("data.azureipam_azure_endpoints.test", "endpoints.0.subscription_id", "6ba4c0d7-1e2f-4a3b-9c4d-5e6f7a8b9c0d"),
+					resource.TestCheckResourceAttr("data.azureipam_azure_endpoints.test", "endpoints.0.tenant_id", "7d2f3c1a-5b4e-4c6d-8e9f-0a1b2c3d4e5f"),
+				),
+			},
+			// Read testing with filters, the resource group is compared case-insensitively
+			{
+				Config: testAccProviderConfig + `data "azureipam_azure_endpoints" "test" {
+					resource_group = "rg-app-prod"
+					prefix         = "10.82.0.0/24"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.azureipam_azure_endpoints.test", "endpoints.#", "1"),
+					resource.TestCheckResourceAttr("data.azureipam_azure_endpoints.test", "endpoints.0.name", "pe-storage-prod"),
+				),
+			},
+			// Invalid prefix filter
+			{
+				Config: testAccProviderConfig + `data "azureipam_azure_endpoints" "test" {
+					prefix = "10.82.0.10"
+				}`,
+				ExpectError: regexp.MustCompile("Invalid CIDR"),
+			},
+		},
+	})
+}
@@ -0,0 +1,184 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	ipamclient "terraform-provider-azureipam/ipamclient"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &azureSubnetsDataSource{}
+	_ datasource.DataSourceWithConfigure = &azureSubnetsDataSource{}
+)
+
+// NewAzureSubnetsDataSource is a helper function to simplify the provider implementation.
+func NewAzureSubnetsDataSource() datasource.DataSource {
+	return &azureSubnetsDataSource{}
+}
+
+// azureSubnetsDataSource is the data source implementation.
+type azureSubnetsDataSource struct {
+	client *ipamclient.Client
+}
+
+// azureSubnetsDataSourceModel maps the data source schema data.
+type azureSubnetsDataSourceModel struct {
+	SubscriptionId types.String       `tfsdk:"subscription_id"`
+	ResourceGroup  types.String       `tfsdk:"resource_group"`
+	Prefix         types.String       `tfsdk:"prefix"`
+	Subnets        []azureSubnetModel `tfsdk:"subnets"`
+}
+
+// Metadata returns the data source type name.
+func (d *azureSubnetsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_azure_subnets"
+}
+
+// Schema defines the schema for the data source.
+func (d *azureSubnetsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "The azure subnets data source allows you to retrieve the Azure subnets discovered by the IPAM application, with their utilization.",
+		Attributes: map[string]schema.Attribute{
+			"subscription_id": schema.StringAttribute{
+				Description: "Id of the subscription for which to filter the `subnets`.",
+				Optional:    true,
+			},
+			"resource_group": schema.StringAttribute{
+				Description: "Name of the resource group for which to filter the `subnets`.",
+				Optional:    true,
+			},
+			"prefix": schema.StringAttribute{
+				Description: "IPV4 range, in cidr notation, for which to filter the `subnets` with the prefix contained in it.",
+				Optional:    true,
+				Validators: []validator.String{
+					ipv4CidrValidator(minPrefixLength, maxExternalPrefixLength),
+				},
+			},
+			"subnets": schema.ListNestedAttribute{
+				Description: "List containing the `subnets` found.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "Name of the subnet.",
+							Computed:    true,
+						},
+						"id": schema.StringAttribute{
+							Description: "Resource Id of the subnet.",
+							Computed:    true,
+						},
+						"prefix": schema.StringAttribute{
+							Description: "The IPV4 prefix assigned to this subnet, in cidr notation.",
+							Computed:    true,
+						},
+						"vnet_name": schema.StringAttribute{
+							Description: "Name of the virtual network of the subnet.",
+							Computed:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "Type of the resources deployed in the subnet, if any, as reported by the IPAM application.",
+							Computed:    true,
+						},
+						"resource_group": schema.StringAttribute{
+							Description: "Name of the resource group where the subnet is deployed.",
+							Computed:    true,
+						},
+						"subscription_id": schema.StringAttribute{
+							Description: "Id of the subscription where the subnet is deployed.",
+							Computed:    true,
+						},
+						"tenant_id": schema.StringAttribute{
+							Description: "Id of the tenant where the subnet is deployed.",
+							Computed:    true,
+						},
+						"size": schema.Float64Attribute{
+							Description: "Total IP's allowed in the `subnet` by its size.",
+							Computed:    true,
+						},
+						"used": schema.Float64Attribute{
+							Description: "Assigned IP's in the `subnet`.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *azureSubnetsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state azureSubnetsDataSourceModel
+
+	// Read Terraform configuration state into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	filter := azureInventoryFilter{
+		SubscriptionId: state.SubscriptionId,
+		ResourceGroup:  state.ResourceGroup,
+		Prefix:         state.Prefix,
+	}
+
+	subnets, err := d.client.GetAzureSubnets(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read AzureIpam Azure Subnets",
+			err.Error(),
+		)
+		return
+	}
+
+	// Map response body to model
+	state.Subnets = []azureSubnetModel{}
+	for _, subnet := range *subnets {
+		matches, err := filter.matches(subnet.SubscriptionId, subnet.ResourceGroup, []string{subnet.Prefix})
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("prefix"),
+				"Invalid Prefix Filter",
+				err.Error(),
+			)
+			return
+		}
+		if matches {
+			state.Subnets = append(state.Subnets, flattenAzureSubnet(&subnet))
+		}
+	}
+
+	// Set state
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *azureSubnetsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ipamclient.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *azureipam.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
@@ -0,0 +1,57 @@
+package provider
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/jarcoal/httpmock"
+)
+
+func TestAccAzureSubnetsDataSource(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("GET", "https://mockedHost.azurewebsites.net/api/azure/subnet",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/datasource/azure_subnets/azure_subnets.json").String()), nil
+		})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing without filters
+			{
+				Config: testAccProviderConfig + `data "azureipam_azure_subnets" "test" {
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify number of subnets returned
+					resource.TestCheckResourceAttr("data.azureipam_azure_subnets.test", "subnets.#", "3"),
+					// Verify the second subnet to ensure all attributes are set
+					resource.TestCheckResourceAttr("data.azureipam_azure_subnets.test", "subnets.1.name", "AzureBastionSubnet"),
+					resource.TestCheckResourceAttr("data.azureipam_azure_subnets.test", "subnets.1.id", "/subscriptions/6ba4c0d7-1e2f-4a3b-9c4d-5e6f7a8b9c0d/resourceGroups/rg-network-prod/providers/Microsoft.Network/virtualNetworks/vnet-prod-aue-01/subnets/AzureBastionSubnet"),
+					resource.TestCheckResourceAttr("data.azureipam_azure_subnets.test", "subnets.1.prefix", "10.82.0.64/26"),
+					resource.TestCheckResourceAttr("data.azureipam_azure_subnets.test", "subnets.1.vnet_name", "vnet-prod-aue-01"),
+					resource.TestCheckResourceAttr("data.azureipam_azure_subnets.test", "subnets.1.type", "bastion"),
+					resource.TestCheckResourceAttr("data.azureipam_azure_subnets.test", "subnets.1.resource_group", "rg-network-prod"),
+					resource.TestCheckResourceAttr("data.azureipam_azure_subnets.test", "subnets.1.subscription_id", "6ba4c0d7-1e2f-4a3b-9c4d-5e6f7a8b9c0d"),
+					resource.TestCheckResourceAttr("data.azureipam_azure_subnets.test", "subnets.1.tenant_id", "7d2f3c1a-5b4e-4c6d-8e9f-0a1b2c3d4e5f"),
+					resource.TestCheckResourceAttr("data.azureipam_azure_subnets.test", "subnets.1.size", "64"),
+					resource.TestCheckResourceAttr("data.azureipam_azure_subnets.test", "subnets.1.used", "5"),
+					// Verify the subnet without type
+					resource.TestCheckNoResourceAttr("data.azureipam_azure_subnets.test", "subnets.0.type"),
+				),
+			},
+			// Read testing with filters
+			{
+				Config: testAccProviderConfig + `data "azureipam_azure_subnets" "test" {
+					subscription_id = "6ba4c0d7-1e2f-4a3b-9c4d-5e6f7a8b9c0d"
+					prefix          = "10.82.0.64/26"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.azureipam_azure_subnets.test", "subnets.#", "1"),
+					resource.TestCheckResourceAttr("data.azureipam_azure_subnets.test", "subnets.0.name", "AzureBastionSubnet"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,209 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	ipamclient "terraform-provider-azureipam/ipamclient"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &azureVnetsDataSource{}
+	_ datasource.DataSourceWithConfigure = &azureVnetsDataSource{}
+)
+
+// NewAzureVnetsDataSource is a helper function to simplify the provider implementation.
+func NewAzureVnetsDataSource() datasource.DataSource {
+	return &azureVnetsDataSource{}
+}
+
+// azureVnetsDataSource is the data source implementation.
+type azureVnetsDataSource struct {
+	client *ipamclient.Client
+}
+
+// azureVnetsDataSourceModel maps the data source schema data.
+type azureVnetsDataSourceModel struct {
+	SubscriptionId types.String     `tfsdk:"subscription_id"`
+	ResourceGroup  types.String     `tfsdk:"resource_group"`
+	Prefix         types.String     `tfsdk:"prefix"`
+	Vnets          []azureVnetModel `tfsdk:"vnets"`
+}
+
+// Metadata returns the data source type name.
+func (d *azureVnetsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_azure_vnets"
+}
+
+// Schema defines the schema for the data source.
+func (d *azureVnetsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "The azure vnets data source allows you to retrieve the Azure virtual networks discovered by the IPAM application, with their utilization.",
+		Attributes: map[string]schema.Attribute{
+			"subscription_id": schema.StringAttribute{
+				Description: "Id of the subscription for which to filter the `vnets`.",
+				Optional:    true,
+			},
+			"resource_group": schema.StringAttribute{
+				Description: "Name of the resource group for which to filter the `vnets`.",
+				Optional:    true,
+			},
+			"prefix": schema.StringAttribute{
+				Description: "IPV4 range, in cidr notation, for which to filter the `vnets` with any prefix contained in it.",
+				Optional:    true,
+				Validators: []validator.String{
+					ipv4CidrValidator(minPrefixLength, maxExternalPrefixLength),
+				},
+			},
+			"vnets": schema.ListNestedAttribute{
+				Description: "List containing the `vnets` found.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "Name of the virtual network.",
+							Computed:    true,
+						},
+						"id": schema.StringAttribute{
+							Description: "Resource Id of the virtual network.",
+							Computed:    true,
+						},
+						"prefixes": schema.ListAttribute{
+							Description: "The list of IPV4 prefixes assigned to this vnet, in cidr notation.",
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+						"subnets": schema.ListNestedAttribute{
+							Description: "List containing the `subnets` included in this `vnet`.",
+							Computed:    true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"name": schema.StringAttribute{
+										Description: "Name of the subnet.",
+										Computed:    true,
+									},
+									"prefix": schema.StringAttribute{
+										Description: "The IPV4 prefix assigned to this subnet, in cidr notation.",
+										Computed:    true,
+									},
+									"size": schema.Float64Attribute{
+										Description: "Total IP's allowed in the `subnet` by its size.",
+										Computed:    true,
+									},
+									"used": schema.Float64Attribute{
+										Description: "Assigned IP's in the `subnet`.",
+										Computed:    true,
+									},
+								},
+							},
+						},
+						"resource_group": schema.StringAttribute{
+							Description: "Name of the resource group where the virtual network is deployed.",
+							Computed:    true,
+						},
+						"subscription_id": schema.StringAttribute{
+							Description: "Id of the subscription where the virtual network is deployed.",
+							Computed:    true,
+						},
+						"tenant_id": schema.StringAttribute{
+							Description: "Id of the tenant where the virtual network is deployed.",
+							Computed:    true,
+						},
+						"size": schema.Float64Attribute{
+							Description: "Total IP's allowed in the `vnet` by its size.",
+							Computed:    true,
+						},
+						"used": schema.Float64Attribute{
+							Description: "Assigned IP's in the `vnet`.",
+							Computed:    true,
+						},
+						"parent_space": schema.StringAttribute{
+							Description: "Name of the space where the virtual network is associated, if any.",
+							Computed:    true,
+						},
+						"parent_block": schema.StringAttribute{
+							Description: "Name of the block where the virtual network is associated, if any.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *azureVnetsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state azureVnetsDataSourceModel
+
+	// Read Terraform configuration state into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	filter := azureInventoryFilter{
+		SubscriptionId: state.SubscriptionId,
+		ResourceGroup:  state.ResourceGroup,
+		Prefix:         state.Prefix,
+	}
+
+	vnets, err := d.client.GetAzureVnets(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read AzureIpam Azure Vnets",
+			err.Error(),
+		)
+		return
+	}
+
+	// Map response body to model
+	state.Vnets = []azureVnetModel{}
+	for _, vnet := range *vnets {
+		matches, err := filter.matches(vnet.SubscriptionId, vnet.ResourceGroup, vnet.Prefixes)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("prefix"),
+				"Invalid Prefix Filter",
+				err.Error(),
+			)
+			return
+		}
+		if matches {
+			state.Vnets = append(state.Vnets, flattenAzureVnet(&vnet))
+		}
+	}
+
+	// Set state
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *azureVnetsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ipamclient.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *azureipam.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
@@ -0,0 +1,73 @@
+package provider
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/jarcoal/httpmock"
+)
+
+func TestAccAzureVnetsDataSource(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("GET", "https://mockedHost.azurewebsites.net/api/azure/vnet",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/datasource/azure_vnets/azure_vnets.json").String()), nil
+		})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing without filters
+			{
+				Config: testAccProviderConfig + `data "azureipam_azure_vnets" "test" {
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify number of vnets returned
+					resource.TestCheckResourceAttr("data.azureipam_azure_vnets.test", "vnets.#", "2"),
+					// Verify the first vnet to ensure all attributes are set
+					resource.TestCheckResourceAttr("data.azureipam_azure_vnets.test", "vnets.0.name", "vnet-prod-aue-01"),
+					resource.TestCheckResourceAttr("data.azureipam_azure_vnets.test", "vnets.0.id", "/subscriptions/6ba4c0d7-1e2f-4a3b-9c4d-5e6f7a8b9c0d/resourceGroups/rg-network-prod/providers/Microsoft.Network/virtualNetworks/vnet-prod-aue-01"),
+					resource.TestCheckResourceAttr("data.azureipam_azure_vnets.test", "vnets.0.prefixes.#", "1"),
+					resource.TestCheckResourceAttr("data.azureipam_azure_vnets.test", "vnets.0.prefixes.0", "10.82.0.0/24"),
+					resource.TestCheckResourceAttr("data.azureipam_azure_vnets.test", "vnets.0.subnets.#", "1"),
+					resource.TestCheckResourceAttr("data.azureipam_azure_vnets.test", "vnets.0.subnets.0.name", "snet-frontend"),
+					resource.TestCheckResourceAttr("data.azureipam_azure_vnets.test", "vnets.0.subnets.0.prefix", "10.82.0.0/26"),
+					resource.TestCheckResourceAttr("data.azureipam_azure_vnets.test", "vnets.0.subnets.0.size", "64"),
+					resource.TestCheckResourceAttr("data.azureipam_azure_vnets.test", "vnets.0.subnets.0.used", "12"),
+					resource.TestCheckResourceAttr("data.azureipam_azure_vnets.test", "vnets.0.resource_group", "rg-network-prod"),
+					resource.TestCheckResourceAttr("data.azureipam_azure_vnets.test", "vnets.0.subscription_id", "6ba4c0d7-1e2f-4a3b-9c4d-5e6f7a8b9c0d"),
+					resource.TestCheckResourceAttr("data.azureipam_azure_vnets.test", "vnets.0.tenant_id", "7d2f3c1a-5b4e-4c6d-8e9f-0a1b2c3d4e5f"),
+					resource.TestCheckResourceAttr("data.azureipam_azure_vnets.test", "vnets.0.size", "256"),
+					resource.TestCheckResourceAttr("data.azureipam_azure_vnets.test", "vnets.0.used", "64"),
+					resource.TestCheckResourceAttr("data.azureipam_azure_vnets.test", "vnets.0.parent_space", "au"),
+					resource.TestCheckResourceAttr("data.azureipam_azure_vnets.test", "vnets.0.parent_block", "AustraliaEast"),
+					// Verify the vnet not associated to any block
+					resource.TestCheckResourceAttr("data.azureipam_azure_vnets.test", "vnets.1.name", "vnet-sandbox-01"),
+					resource.TestCheckNoResourceAttr("data.azureipam_azure_vnets.test", "vnets.1.parent_space"),
+					resource.TestCheckNoResourceAttr("data.azureipam_azure_vnets.test", "vnets.1.parent_block"),
+				),
+			},
+			// Read testing with filters
+			{
+				Config: testAccProviderConfig + `data "azureipam_azure_vnets" "test" {
+					subscription_id = "6BA4C0D7-1E2F-4A3B-9C4D-5E6F7A8B9C0D"
+					resource_group  = "rg-network-prod"
+					prefix          = "10.82.0.0/16"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.azureipam_azure_vnets.test", "vnets.#", "1"),
+					resource.TestCheckResourceAttr("data.azureipam_azure_vnets.test", "vnets.0.name", "vnet-prod-aue-01"),
+				),
+			},
+			// Read testing with filters without matches
+			{
+				Config: testAccProviderConfig + `data "azureipam_azure_vnets" "test" {
+					prefix = "172.16.0.0/12"
+				}`,
+				Check: resource.TestCheckResourceAttr("data.azureipam_azure_vnets.test", "vnets.#", "0"),
+			},
+		},
+	})
+}
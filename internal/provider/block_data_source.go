@@ -218,7 +218,7 @@ func (d *blockDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	// Read Terraform configuration state into the model
 	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
 
-	block, err := d.client.GetBlockInfo(
+	block, err := d.client.GetBlockInfo(ctx,
 		state.Space.ValueString(),
 		state.Name.ValueString(),
 		state.Expand.ValueBool(),
@@ -0,0 +1,416 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	ipamclient "terraform-provider-azureipam/ipamclient"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &blockExternalsResource{}
+	_ resource.ResourceWithConfigure      = &blockExternalsResource{}
+	_ resource.ResourceWithImportState    = &blockExternalsResource{}
+	_ resource.ResourceWithValidateConfig = &blockExternalsResource{}
+)
+
+// blockExternalAttrTypes are the attribute types of the externals set elements.
+var blockExternalAttrTypes = map[string]attr.Type{
+	"name":        types.StringType,
+	"description": types.StringType,
+	"cidr":        types.StringType,
+}
+
+// NewBlockExternalsResource is a helper function to simplify the provider implementation.
+func NewBlockExternalsResource() resource.Resource {
+	return &blockExternalsResource{}
+}
+
+// blockExternalsResourceModel maps the resource schema data.
+type blockExternalsResourceModel struct {
+	Id        types.String   `tfsdk:"id"`
+	Space     types.String   `tfsdk:"space"`
+	Block     types.String   `tfsdk:"block"`
+	Externals types.Set      `tfsdk:"externals"`
+	Timeouts  timeouts.Value `tfsdk:"timeouts"`
+}
+
+// blockExternalsResource is the resource implementation.
+type blockExternalsResource struct {
+	client *ipamclient.Client
+}
+
+// Metadata returns the resource type name.
+func (r *blockExternalsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_block_externals"
+}
+
+// Schema defines the schema for the resource.
+func (r *blockExternalsResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "The block_externals resource allows you to manage the full list of external networks of the target space and block, applied in a single request. The resource is authoritative, the external networks added outside terraform are removed from the block, and all the external networks are removed when the resource is destroyed. It must not be combined with `azureipam_external` resources for the same block. The subnets of the external networks kept in the list are preserved.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of the block externals, in the `{space}/{block}` format.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"space": schema.StringAttribute{
+				Description: "Name of the space where the externals must be associated. Changing this forces a new resource to be created.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"block": schema.StringAttribute{
+				Description: "Name of the block where the externals must be associated. Changing this forces a new resource to be created.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"externals": schema.SetNestedAttribute{
+				Description: "The set of external networks of the block. The names must be unique, and an empty set removes all the external networks.",
+				Required:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "Name of the external network.",
+							Required:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "Text that describes the external network.",
+							Required:    true,
+						},
+						"cidr": schema.StringAttribute{
+							Description: "The IP range to configure to the external network, in cidr notation. Only IPv4 ranges with a prefix length between 8 and 32 are allowed, IPv6 ranges are rejected since they are not supported by the IPAM engine.",
+							Required:    true,
+							Validators: []validator.String{
+								ipv4CidrValidator(minPrefixLength, maxExternalPrefixLength),
+							},
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// ValidateConfig validates that the names of the external networks are unique.
+func (r *blockExternalsResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config blockExternalsResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() || config.Externals.IsNull() || config.Externals.IsUnknown() {
+		return
+	}
+
+	var externals []externalModel
+	resp.Diagnostics.Append(config.Externals.ElementsAs(ctx, &externals, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	names := map[string]bool{}
+	for _, external := range externals {
+		if external.Name.IsNull() || external.Name.IsUnknown() {
+			continue
+		}
+		if names[external.Name.ValueString()] {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("externals"),
+				"Duplicate External Network Name",
+				"The external network name "+external.Name.ValueString()+" is configured more than once, the names must be unique in the block.",
+			)
+		}
+		names[external.Name.ValueString()] = true
+	}
+}
+
+// Create a new resource.
+func (r *blockExternalsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan blockExternalsResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	externals, diags := expandBlockExternals(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	//replace the existing external networks, since the resource is authoritative
+	replaced, err := r.client.ReplaceExternals(ctx,
+		plan.Space.ValueString(),
+		plan.Block.ValueString(),
+		externals,
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating block externals",
+			"Could not create block externals, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	resp.Diagnostics.Append(flattenBlockExternals(replaced, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read resource information.
+func (r *blockExternalsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state blockExternalsResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	//read external networks
+	externals, err := r.client.GetExternalsInfo(ctx,
+		state.Space.ValueString(),
+		state.Block.ValueString(),
+	)
+	if ipamclient.IsNotFound(err) {
+		//block deleted outside terraform, remove from state to plan its recreation
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading AzureIpam block externals",
+			"Could not read AzureIpam block externals of block "+state.Id.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Overwrite items with refreshed state
+	resp.Diagnostics.Append(flattenBlockExternals(externals, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update replaces the external networks with the planned ones.
+func (r *blockExternalsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan blockExternalsResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	externals, diags := expandBlockExternals(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	//Replace the external networks
+	replaced, err := r.client.ReplaceExternals(ctx,
+		plan.Space.ValueString(),
+		plan.Block.ValueString(),
+		externals,
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating block externals",
+			"Could not update block externals, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	resp.Diagnostics.Append(flattenBlockExternals(replaced, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *blockExternalsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state blockExternalsResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Remove all the external networks
+	_, err := r.client.ReplaceExternals(ctx,
+		state.Space.ValueString(),
+		state.Block.ValueString(),
+		[]ipamclient.ExternalInfo{},
+	)
+	if err != nil && !ipamclient.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting AzureIpam block externals",
+			"Could not delete block externals, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *blockExternalsResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ipamclient.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *azureipam.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *blockExternalsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID, validate, split and save to space and block attributes
+	re := regexp.MustCompile("^(?<space>[a-zA-Z0-9]+)/(?<block>[a-zA-Z0-9]+)$")
+
+	//validate
+	if !re.MatchString(req.ID) {
+		resp.Diagnostics.AddError(
+			"Error Importing AzureIpam block externals",
+			"The specified ID is not in the correct format {SpaceName}/{BlockName}.",
+		)
+		return
+	}
+	//extract values
+	matches := re.FindStringSubmatch(req.ID)
+	space := matches[re.SubexpIndex("space")]
+	block := matches[re.SubexpIndex("block")]
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("space"), space)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("block"), block)...)
+}
+
+func expandBlockExternals(ctx context.Context, model *blockExternalsResourceModel) ([]ipamclient.ExternalInfo, diag.Diagnostics) {
+	var externalModels []externalModel
+	diags := model.Externals.ElementsAs(ctx, &externalModels, false)
+
+	externals := []ipamclient.ExternalInfo{}
+	for _, external := range externalModels {
+		externals = append(externals, ipamclient.ExternalInfo{
+			Name:        external.Name.ValueString(),
+			Description: external.Description.ValueString(),
+			Cidr:        external.Cidr.ValueString(),
+		})
+	}
+
+	return externals, diags
+}
+
+func flattenBlockExternals(externals *[]ipamclient.ExternalInfo, model *blockExternalsResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	model.Id = types.StringValue(model.Space.ValueString() + "/" + model.Block.ValueString())
+	elements := []attr.Value{}
+	for _, external := range *externals {
+		element, elementDiags := types.ObjectValue(blockExternalAttrTypes, map[string]attr.Value{
+			"name":        types.StringValue(external.Name),
+			"description": types.StringValue(external.Description),
+			"cidr":        types.StringValue(external.Cidr),
+		})
+		diags.Append(elementDiags...)
+		elements = append(elements, element)
+	}
+	var setDiags diag.Diagnostics
+	model.Externals, setDiags = types.SetValue(types.ObjectType{AttrTypes: blockExternalAttrTypes}, elements)
+	diags.Append(setDiags...)
+
+	return diags
+}
@@ -0,0 +1,277 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"testing"
+
+	ipamclient "terraform-provider-azureipam/ipamclient"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/jarcoal/httpmock"
+)
+
+// registerBlockExternalsResponders mocks the externals endpoints, storing the list of external networks replaced.
+func registerBlockExternalsResponders(externals *[]ipamclient.ExternalInfo, puts *int) {
+	httpmock.RegisterResponder("GET", "https://mockedHost.azurewebsites.net/api/spaces/au/blocks/AustraliaEast/externals",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewJsonResponse(http.StatusOK, *externals)
+		})
+	httpmock.RegisterResponder("PUT", "https://mockedHost.azurewebsites.net/api/spaces/au/blocks/AustraliaEast/externals",
+		func(req *http.Request) (*http.Response, error) {
+			*puts++
+			body, err := io.ReadAll(req.Body)
+			if err != nil {
+				return nil, err
+			}
+			if err := json.Unmarshal(body, externals); err != nil || *externals == nil {
+				return httpmock.NewStringResponse(http.StatusBadRequest, `{"error":"Invalid request body."}`), nil
+			}
+			return httpmock.NewJsonResponse(http.StatusOK, *externals)
+		})
+}
+
+func TestAccBlockExternalsResource(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	externals := []ipamclient.ExternalInfo{}
+	if err := json.Unmarshal([]byte(httpmock.File("tests/datasource/externals/externals_all.json").String()), &externals); err != nil {
+		t.Fatal(err)
+	}
+	puts := 0
+	registerBlockExternalsResponders(&externals, &puts)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing, replacing the existing externals in a single request
+			{
+				Config: testAccProviderConfig + `resource "azureipam_block_externals" "test" {
+					space = "au"
+					block = "AustraliaEast"
+					externals = [
+						{
+							name        = "acctest"
+							description = "External Network for Acceptance Tests"
+							cidr        = "10.83.1.0/24"
+						},
+						{
+							name        = "onprem"
+							description = "On-premises datacenter"
+							cidr        = "10.90.0.0/16"
+						},
+					]
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					//Verify common attributes to ensure that all are set
+					resource.TestCheckResourceAttr("azureipam_block_externals.test", "id", "au/AustraliaEast"),
+					resource.TestCheckResourceAttr("azureipam_block_externals.test", "externals.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("azureipam_block_externals.test", "externals.*", map[string]string{
+						"name":        "acctest",
+						"description": "External Network for Acceptance Tests",
+						"cidr":        "10.83.1.0/24",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("azureipam_block_externals.test", "externals.*", map[string]string{
+						"name":        "onprem",
+						"description": "On-premises datacenter",
+						"cidr":        "10.90.0.0/16",
+					}),
+					func(_ *terraform.State) error {
+						if puts != 1 {
+							return fmt.Errorf("expected 1 PUT request, got %d", puts)
+						}
+						return nil
+					},
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "azureipam_block_externals.test",
+				ImportState:       true,
+				ImportStateId:     "au/AustraliaEast",
+				ImportStateVerify: true,
+			},
+			// ImportState testing with an incomplete ID
+			{
+				ResourceName:  "azureipam_block_externals.test",
+				ImportState:   true,
+				ImportStateId: "au/",
+				ExpectError:   regexp.MustCompile("The specified ID is not in the correct format"),
+			},
+			// Update and Read testing
+			{
+				Config: testAccProviderConfig + `resource "azureipam_block_externals" "test" {
+					space = "au"
+					block = "AustraliaEast"
+					externals = [
+						{
+							name        = "onprem"
+							description = "On-premises datacenter updated"
+							cidr        = "10.90.0.0/17"
+						},
+					]
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					//Verify attributes after update to ensure that all are set
+					resource.TestCheckResourceAttr("azureipam_block_externals.test", "externals.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs("azureipam_block_externals.test", "externals.*", map[string]string{
+						"name":        "onprem",
+						"description": "On-premises datacenter updated",
+						"cidr":        "10.90.0.0/17",
+					}),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+		CheckDestroy: func(_ *terraform.State) error {
+			if len(externals) != 0 {
+				return fmt.Errorf("expected no externals after destroy, got %v", externals)
+			}
+			return nil
+		},
+	})
+}
+
+func TestAccBlockExternalsResourceDrift(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	externals := []ipamclient.ExternalInfo{}
+	puts := 0
+	registerBlockExternalsResponders(&externals, &puts)
+
+	config := testAccProviderConfig + `resource "azureipam_block_externals" "test" {
+		space = "au"
+		block = "AustraliaEast"
+		externals = [
+			{
+				name        = "onprem"
+				description = "On-premises datacenter"
+				cidr        = "10.90.0.0/16"
+			},
+		]
+	}`
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: config,
+				Check:  resource.TestCheckResourceAttr("azureipam_block_externals.test", "externals.#", "1"),
+			},
+			// External network added outside terraform, its removal must be planned
+			{
+				PreConfig: func() {
+					externals = append(externals, ipamclient.ExternalInfo{Name: "manual", Description: "Added in the portal", Cidr: "10.91.0.0/24"})
+				},
+				Config:             config,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccBlockExternalsResourceKeepsSubnets(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	externals := []ipamclient.ExternalInfo{}
+	if err := json.Unmarshal([]byte(httpmock.File("tests/resource/external/externals_with_subnets.json").String()), &externals); err != nil {
+		t.Fatal(err)
+	}
+	puts := 0
+	registerBlockExternalsResponders(&externals, &puts)
+
+	//the mocked engine stores the PUT body, so the subnets are only kept when they are sent again
+	checkSubnets := func(_ *terraform.State) error {
+		for _, external := range externals {
+			if external.Name == "acctest" {
+				if len(external.Subnets) != 2 || external.Subnets[0].Name != "subnet1" || external.Subnets[1].Name != "subnet2" {
+					return fmt.Errorf("expected the subnets of acctest to be kept, got %v", external.Subnets)
+				}
+				return nil
+			}
+		}
+		return fmt.Errorf("expected the acctest external network, got %v", externals)
+	}
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccProviderConfig + `resource "azureipam_block_externals" "test" {
+					space = "au"
+					block = "AustraliaEast"
+					externals = [
+						{
+							name        = "acctest"
+							description = "External Network for Acceptance Tests"
+							cidr        = "10.83.1.0/24"
+						},
+					]
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("azureipam_block_externals.test", "externals.#", "1"),
+					checkSubnets,
+				),
+			},
+			// Update and Read testing
+			{
+				Config: testAccProviderConfig + `resource "azureipam_block_externals" "test" {
+					space = "au"
+					block = "AustraliaEast"
+					externals = [
+						{
+							name        = "acctest"
+							description = "External Network for Acceptance Tests updated"
+							cidr        = "10.83.1.0/24"
+						},
+						{
+							name        = "onprem"
+							description = "On-premises datacenter"
+							cidr        = "10.90.0.0/16"
+						},
+					]
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("azureipam_block_externals.test", "externals.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("azureipam_block_externals.test", "externals.*", map[string]string{
+						"name":        "acctest",
+						"description": "External Network for Acceptance Tests updated",
+					}),
+					checkSubnets,
+				),
+			},
+		},
+	})
+}
+
+func TestAccBlockExternalsResourceDuplicateName(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig + `resource "azureipam_block_externals" "test" {
+					space = "au"
+					block = "AustraliaEast"
+					externals = [
+						{
+							name        = "onprem"
+							description = "On-premises datacenter"
+							cidr        = "10.90.0.0/16"
+						},
+						{
+							name        = "onprem"
+							description = "On-premises datacenter duplicated"
+							cidr        = "10.91.0.0/16"
+						},
+					]
+				}`,
+				ExpectError: regexp.MustCompile("Duplicate External Network Name"),
+			},
+		},
+	})
+}
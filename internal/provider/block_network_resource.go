@@ -8,14 +8,20 @@ import (
 	ipamclient "terraform-provider-azureipam/ipamclient"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
+// blockNetworkIdRegex matches the Azure Resource IDs of the virtual networks and Virtual WAN hubs.
+var blockNetworkIdRegex = regexp.MustCompile(`(?i)^/subscriptions/[^/]+/resourceGroups/[^/]+/providers/Microsoft\.Network/(virtualNetworks|virtualHubs)/[^/]+$`)
+
 // Ensure the implementation satisfies the expected interfaces.
 var (
 	_ resource.Resource                = &blockNetworkResource{}
@@ -30,14 +36,17 @@ func NewBlockNetworkResource() resource.Resource {
 
 // blockNetworkResourceModel maps the resource schema data.
 type blockNetworkResourceModel struct {
-	Space          types.String `tfsdk:"space"`
-	Block          types.String `tfsdk:"block"`
-	Id             types.String `tfsdk:"id"`
-	Name           types.String `tfsdk:"name"`
-	Prefixes       types.List   `tfsdk:"prefixes"`
-	ResourceGroup  types.String `tfsdk:"resource_group"`
-	SubscriptionId types.String `tfsdk:"subscription_id"`
-	TenantId       types.String `tfsdk:"tenant_id"`
+	Space          types.String   `tfsdk:"space"`
+	Block          types.String   `tfsdk:"block"`
+	Id             types.String   `tfsdk:"id"`
+	Name           types.String   `tfsdk:"name"`
+	Type           types.String   `tfsdk:"type"`
+	Prefixes       types.List     `tfsdk:"prefixes"`
+	AddressPrefix  types.String   `tfsdk:"address_prefix"`
+	ResourceGroup  types.String   `tfsdk:"resource_group"`
+	SubscriptionId types.String   `tfsdk:"subscription_id"`
+	TenantId       types.String   `tfsdk:"tenant_id"`
+	Timeouts       timeouts.Value `tfsdk:"timeouts"`
 }
 
 // blockNetworkResource is the resource implementation.
@@ -51,9 +60,9 @@ func (r *blockNetworkResource) Metadata(_ context.Context, req resource.Metadata
 }
 
 // Schema defines the schema for the resource.
-func (r *blockNetworkResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *blockNetworkResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "The block_network resource allow to associate an existing azure network to the target block.",
+		Description: "The block_network resource allow to associate an existing azure virtual network or Virtual WAN hub to the target block.",
 		Attributes: map[string]schema.Attribute{
 			"space": schema.StringAttribute{
 				Description: "Name of the space where the external must be associated. Changing this forces a new resource to be created.",
@@ -70,34 +79,52 @@ func (r *blockNetworkResource) Schema(_ context.Context, _ resource.SchemaReques
 				},
 			},
 			"id": schema.StringAttribute{
-				Description: "Azure Resource ID of the virtual network to associate.",
+				Description: "Azure Resource ID of the virtual network or Virtual WAN hub to associate.",
 				Required:    true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(blockNetworkIdRegex, "must be the Azure Resource ID of a virtual network or Virtual WAN hub"),
+				},
 			},
 			"name": schema.StringAttribute{
-				Description: "Name of the Azure virtual network.",
+				Description: "Name of the Azure virtual network or Virtual WAN hub.",
+				Computed:    true,
+			},
+			"type": schema.StringAttribute{
+				Description: "Type of the network, `vnet` for virtual networks or `vhub` for Virtual WAN hubs.",
 				Computed:    true,
 			},
 			"prefixes": schema.ListAttribute{
-				Description: "The list of IPV4 prefixes assigned to this vnet, in cidr notation.",
+				Description: "The list of IPV4 prefixes assigned to this network, in cidr notation.",
 				Computed:    true,
 				ElementType: types.StringType,
 			},
+			"address_prefix": schema.StringAttribute{
+				Description: "The IPV4 address prefix of the Virtual WAN hub, in cidr notation. Only set when `type` is `vhub`.",
+				Computed:    true,
+			},
 			"resource_group": schema.StringAttribute{
-				Description: "Name of the resource group where the `vnet` is deployed.",
+				Description: "Name of the resource group where the network is deployed.",
 				Computed:    true,
 			},
 			"subscription_id": schema.StringAttribute{
-				Description: "Id of the Azure subscription where the `vnet` is deployed.",
+				Description: "Id of the Azure subscription where the network is deployed.",
 				Computed:    true,
 			},
 			"tenant_id": schema.StringAttribute{
-				Description: "Id of the Azure tenant where the `vnet` is deployed.",
+				Description: "Id of the Azure tenant where the network is deployed.",
 				Computed:    true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -111,7 +138,15 @@ func (r *blockNetworkResource) Create(ctx context.Context, req resource.CreateRe
 		return
 	}
 
-	block, err := r.client.CreateBlockNetwork(
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	block, err := r.client.CreateBlockNetwork(ctx,
 		plan.Space.ValueString(),
 		plan.Block.ValueString(),
 		plan.Id.ValueString(),
@@ -149,13 +184,26 @@ func (r *blockNetworkResource) Read(ctx context.Context, req resource.ReadReques
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	//read external
-	block, err := r.client.GetBlockNetworkInfo(
+	block, err := r.client.GetBlockNetworkInfo(ctx,
 		state.Space.ValueString(),
 		state.Block.ValueString(),
 		state.Id.ValueString(),
 		true,
 	)
+	if ipamclient.IsNotFound(err) {
+		//deleted outside terraform, remove from state to plan its recreation
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading AzureIpam block network",
@@ -179,16 +227,24 @@ func (r *blockNetworkResource) Read(ctx context.Context, req resource.ReadReques
 	}
 }
 
-// Update not allowed, returning readed plan as current state.
+// Update not allowed, only the attributes that don't force a new resource are copied from the plan to the
+// current state, keeping the computed attributes that are unknown in the plan.
 func (n *blockNetworkResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var model blockNetworkResourceModel
+	var state blockNetworkResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	var plan blockNetworkResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+	state.Timeouts = plan.Timeouts
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
 func (r *blockNetworkResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -200,8 +256,16 @@ func (r *blockNetworkResource) Delete(ctx context.Context, req resource.DeleteRe
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	// Delete existing external network
-	err := r.client.DeleteBlockNetwork(
+	err := r.client.DeleteBlockNetwork(ctx,
 		state.Space.ValueString(),
 		state.Block.ValueString(),
 		state.Id.ValueString(),
@@ -264,10 +328,12 @@ func flattenBlockNetwork(ctx context.Context, external *ipamclient.BlockNetworkI
 
 	model.Id = types.StringValue(external.Id)
 	model.Name = types.StringValue(external.Name)
+	model.Type = types.StringValue(external.Type)
 	model.Prefixes, diags = types.ListValueFrom(ctx, types.StringType, external.Prefixes)
-	model.ResourceGroup = types.StringValue(*external.ResourceGroup)
-	model.SubscriptionId = types.StringValue(*external.SubscriptionId)
-	model.TenantId = types.StringValue(*external.TenantId)
+	model.AddressPrefix = types.StringPointerValue(external.AddressPrefix)
+	model.ResourceGroup = types.StringPointerValue(external.ResourceGroup)
+	model.SubscriptionId = types.StringPointerValue(external.SubscriptionId)
+	model.TenantId = types.StringPointerValue(external.TenantId)
 
 	return diags
 }
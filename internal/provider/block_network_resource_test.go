@@ -2,6 +2,7 @@ package provider
 
 import (
 	"net/http"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -40,6 +41,8 @@ func TestAccBlockNetworkResource(t *testing.T) {
 					resource.TestCheckResourceAttr("azureipam_block_network.test", "block", "AustraliaEast"),
 					resource.TestCheckResourceAttr("azureipam_block_network.test", "id", "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/RG-WE-ALL-COMMS-01/providers/Microsoft.Network/virtualNetworks/vnet-we-d-terratest-hub-01"),
 					resource.TestCheckResourceAttr("azureipam_block_network.test", "name", "vnet-we-d-terratest-hub-01"),
+					resource.TestCheckResourceAttr("azureipam_block_network.test", "type", "vnet"),
+					resource.TestCheckNoResourceAttr("azureipam_block_network.test", "address_prefix"),
 					resource.TestCheckResourceAttr("azureipam_block_network.test", "resource_group", "rg-we-all-comms-01"),
 					resource.TestCheckResourceAttr("azureipam_block_network.test", "subscription_id", "00000000-0000-0000-0000-000000000000"),
 					resource.TestCheckResourceAttr("azureipam_block_network.test", "tenant_id", "11111111-1111-1111-1111-111111111111"),
@@ -63,3 +66,141 @@ func TestAccBlockNetworkResource(t *testing.T) {
 		},
 	})
 }
+
+func TestAccBlockNetworkResourceVirtualHub(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterMatcherResponder("POST", "https://mockedHost.azurewebsites.net/api/spaces/au/blocks/AustraliaEast/networks",
+		httpmock.BodyContainsString(`"id":"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/RG-WE-ALL-COMMS-01/providers/Microsoft.Network/virtualHubs/vhub-we-p-01"`),
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/resource/networks/space_with_new_hub.json").String()), nil
+		})
+	httpmock.RegisterResponder("GET", "https://mockedHost.azurewebsites.net/api/spaces/au/blocks/AustraliaEast/networks?expand=true",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/resource/networks/networks_with_new_hub.json").String()), nil
+		})
+	httpmock.RegisterResponder("DELETE", "https://mockedHost.azurewebsites.net/api/spaces/au/blocks/AustraliaEast/networks",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, ""), nil
+		})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccProviderConfig + `resource "azureipam_block_network" "test" {
+					space = "au"
+					block = "AustraliaEast"
+					id    = "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/RG-WE-ALL-COMMS-01/providers/Microsoft.Network/virtualHubs/vhub-we-p-01"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("azureipam_block_network.test", "name", "vhub-we-p-01"),
+					resource.TestCheckResourceAttr("azureipam_block_network.test", "type", "vhub"),
+					resource.TestCheckResourceAttr("azureipam_block_network.test", "address_prefix", "10.82.4.0/23"),
+					resource.TestCheckResourceAttr("azureipam_block_network.test", "prefixes.#", "1"),
+					resource.TestCheckResourceAttr("azureipam_block_network.test", "prefixes.0", "10.82.4.0/23"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         "azureipam_block_network.test",
+				ImportState:                          true,
+				ImportStateId:                        "au/AustraliaEast//subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/RG-WE-ALL-COMMS-01/providers/Microsoft.Network/virtualHubs/vhub-we-p-01",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "name",
+			},
+		},
+	})
+}
+
+func TestAccBlockNetworkResourceInvalidId(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig + `resource "azureipam_block_network" "test" {
+					space = "au"
+					block = "AustraliaEast"
+					id    = "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/RG-WE-ALL-COMMS-01/providers/Microsoft.Network/publicIPAddresses/pip-01"
+				}`,
+				ExpectError: regexp.MustCompile("virtual network or Virtual WAN hub"),
+			},
+		},
+	})
+}
+
+func TestAccBlockNetworkResourceDeletedOutsideTerraform(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	deleted := false
+	httpmock.RegisterResponder("POST", "https://mockedHost.azurewebsites.net/api/spaces/au/blocks/AustraliaEast/networks",
+		func(req *http.Request) (*http.Response, error) {
+			deleted = false
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/resource/networks/space_with_new_network.json").String()), nil
+		})
+	httpmock.RegisterResponder("GET", "https://mockedHost.azurewebsites.net/api/spaces/au/blocks/AustraliaEast/networks?expand=true",
+		func(req *http.Request) (*http.Response, error) {
+			if deleted {
+				return httpmock.NewStringResponse(http.StatusOK, "[]"), nil
+			}
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/resource/networks/networks_with_new_network.json").String()), nil
+		})
+	httpmock.RegisterResponder("DELETE", "https://mockedHost.azurewebsites.net/api/spaces/au/blocks/AustraliaEast/networks",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, ""), nil
+		})
+
+	config := testAccProviderConfig + `resource "azureipam_block_network" "test" {
+		space = "au"
+		block = "AustraliaEast"
+		id    = "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/RG-WE-ALL-COMMS-01/providers/Microsoft.Network/virtualNetworks/vnet-we-d-terratest-hub-01"
+	}`
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: config,
+				Check:  resource.TestCheckResourceAttr("azureipam_block_network.test", "name", "vnet-we-d-terratest-hub-01"),
+			},
+			// Deleted outside terraform, recreation must be planned
+			{
+				PreConfig:          func() { deleted = true },
+				Config:             config,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccBlockNetworkResourceCreateTimeout(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("POST", "https://mockedHost.azurewebsites.net/api/spaces/au/blocks/AustraliaEast/networks",
+		func(req *http.Request) (*http.Response, error) {
+			//never answers, waiting until the operation times out
+			<-req.Context().Done()
+			return nil, req.Context().Err()
+		})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create testing
+			{
+				Config: testAccProviderConfig + `resource "azureipam_block_network" "test" {
+					space = "au"
+					block = "AustraliaEast"
+					id    = "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/RG-WE-ALL-COMMS-01/providers/Microsoft.Network/virtualNetworks/vnet-we-d-terratest-hub-01"
+
+					timeouts {
+						create = "2s"
+					}
+				}`,
+				ExpectError: regexp.MustCompile("context deadline exceeded"),
+			},
+		},
+	})
+}
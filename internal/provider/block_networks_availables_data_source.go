@@ -29,9 +29,16 @@ type blockNetworksAvailablesDataSource struct {
 
 // blockNetworksAvailablesDataSourceModel maps the data source schema data.
 type blockNetworksAvailablesDataSourceModel struct {
-	Space types.String   `tfsdk:"space"`
-	Block types.String   `tfsdk:"block"`
-	Ids   []types.String `tfsdk:"ids"`
+	Space    types.String                 `tfsdk:"space"`
+	Block    types.String                 `tfsdk:"block"`
+	Ids      []types.String               `tfsdk:"ids"`
+	Networks []blockNetworkAvailableModel `tfsdk:"networks"`
+}
+
+// blockNetworkAvailableModel maps the available network schema data.
+type blockNetworkAvailableModel struct {
+	Id   types.String `tfsdk:"id"`
+	Type types.String `tfsdk:"type"`
 }
 
 // Metadata returns the data source type name.
@@ -42,7 +49,7 @@ func (d *blockNetworksAvailablesDataSource) Metadata(_ context.Context, req data
 // Schema defines the schema for the data source.
 func (d *blockNetworksAvailablesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "The block network availables data source allows you to retrieve information of the azure virtual networks availables to be associated with a space and block.",
+		Description: "The block network availables data source allows you to retrieve information of the azure virtual networks and Virtual WAN hubs availables to be associated with a space and block.",
 		Attributes: map[string]schema.Attribute{
 			"space": schema.StringAttribute{
 				Description: "Name of the space where to search for networks availables.",
@@ -53,10 +60,26 @@ func (d *blockNetworksAvailablesDataSource) Schema(_ context.Context, _ datasour
 				Required:    true,
 			},
 			"ids": schema.ListAttribute{
-				Description: "The list of of the Azure virtual networs resource Ids that can be associated to the block.",
+				Description: "The list of of the Azure virtual networs and Virtual WAN hubs resource Ids that can be associated to the block.",
 				Computed:    true,
 				ElementType: types.StringType,
 			},
+			"networks": schema.ListNestedAttribute{
+				Description: "List containing the networks that can be associated to the block, with their type.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "Azure Resource ID of the virtual network or Virtual WAN hub.",
+							Computed:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "Type of the network, `vnet` for virtual networks or `vhub` for Virtual WAN hubs.",
+							Computed:    true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -68,7 +91,7 @@ func (d *blockNetworksAvailablesDataSource) Read(ctx context.Context, req dataso
 	// Read Terraform configuration state into the model
 	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
 
-	ids, err := d.client.GetBlockNetworksAvailables(
+	ids, err := d.client.GetBlockNetworksAvailables(ctx,
 		state.Space.ValueString(),
 		state.Block.ValueString(),
 	)
@@ -83,6 +106,10 @@ func (d *blockNetworksAvailablesDataSource) Read(ctx context.Context, req dataso
 	// Map response body to model
 	for _, id := range *ids {
 		state.Ids = append(state.Ids, types.StringValue(id))
+		state.Networks = append(state.Networks, blockNetworkAvailableModel{
+			Id:   types.StringValue(id),
+			Type: types.StringValue(ipamclient.GetBlockNetworkType(id)),
+		})
 	}
 
 	// Set state
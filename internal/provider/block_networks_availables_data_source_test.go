@@ -37,3 +37,33 @@ func TestAccBlockNetworksAvailablesDataSource(t *testing.T) {
 		},
 	})
 }
+
+func TestAccBlockNetworksAvailablesDataSourceVirtualHub(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("GET", "https://mockedHost.azurewebsites.net/api/spaces/au/blocks/AustraliaEast/available",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/datasource/block_networks/availables_with_hub.json").String()), nil
+		})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccProviderConfig + `data "azureipam_block_networks_availables" "test" {
+					space  = "au"
+					block  = "AustraliaEast"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.azureipam_block_networks_availables.test", "ids.#", "3"),
+					resource.TestCheckResourceAttr("data.azureipam_block_networks_availables.test", "networks.#", "3"),
+					// Verify the types of the networks
+					resource.TestCheckResourceAttr("data.azureipam_block_networks_availables.test", "networks.0.type", "vnet"),
+					resource.TestCheckResourceAttr("data.azureipam_block_networks_availables.test", "networks.2.id", "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/RG-WE-ALL-COMMS-01/providers/Microsoft.Network/virtualHubs/vhub-we-p-01"),
+					resource.TestCheckResourceAttr("data.azureipam_block_networks_availables.test", "networks.2.type", "vhub"),
+				),
+			},
+		},
+	})
+}
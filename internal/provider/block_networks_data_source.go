@@ -42,7 +42,7 @@ func (d *blockNetworksDataSource) Metadata(_ context.Context, req datasource.Met
 // Schema defines the schema for the data source.
 func (d *blockNetworksDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "The block networks data source allows you to retrieve information of the azure virtual networks actively associated to the specified space and block.",
+		Description: "The block networks data source allows you to retrieve information of the azure virtual networks and Virtual WAN hubs actively associated to the specified space and block.",
 		Attributes: map[string]schema.Attribute{
 			"space": schema.StringAttribute{
 				Description: "Name of the space where to search the associated block networks.",
@@ -53,33 +53,41 @@ func (d *blockNetworksDataSource) Schema(_ context.Context, _ datasource.SchemaR
 				Required:    true,
 			},
 			"networks": schema.ListNestedAttribute{
-				Description: "List containing the `vnet` included in this `block`.",
+				Description: "List containing the `vnet` and `vhub` networks included in this `block`.",
 				Computed:    true,
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
 						"id": schema.StringAttribute{
-							Description: "Azure Resource ID of the virtual network already associated.",
+							Description: "Azure Resource ID of the virtual network or Virtual WAN hub already associated.",
 							Computed:    true,
 						},
 						"name": schema.StringAttribute{
-							Description: "Name of the Azure virtual network.",
+							Description: "Name of the Azure virtual network or Virtual WAN hub.",
+							Computed:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "Type of the network, `vnet` for virtual networks or `vhub` for Virtual WAN hubs.",
 							Computed:    true,
 						},
 						"prefixes": schema.ListAttribute{
-							Description: "The list of IPV4 prefixes assigned to this vnet, in cidr notation.",
+							Description: "The list of IPV4 prefixes assigned to this network, in cidr notation.",
 							Computed:    true,
 							ElementType: types.StringType,
 						},
+						"address_prefix": schema.StringAttribute{
+							Description: "The IPV4 address prefix of the Virtual WAN hub, in cidr notation. Only set when `type` is `vhub`.",
+							Computed:    true,
+						},
 						"resource_group": schema.StringAttribute{
-							Description: "Name of the resource group where the `vnet` is deployed.",
+							Description: "Name of the resource group where the network is deployed.",
 							Computed:    true,
 						},
 						"subscription_id": schema.StringAttribute{
-							Description: "Id of the Azure subscription where the `vnet` is deployed.",
+							Description: "Id of the Azure subscription where the network is deployed.",
 							Computed:    true,
 						},
 						"tenant_id": schema.StringAttribute{
-							Description: "Id of the Azure tenant where the `vnet` is deployed.",
+							Description: "Id of the Azure tenant where the network is deployed.",
 							Computed:    true,
 						},
 					},
@@ -96,7 +104,7 @@ func (d *blockNetworksDataSource) Read(ctx context.Context, req datasource.ReadR
 	// Read Terraform configuration state into the model
 	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
 
-	blockNetworks, err := d.client.GetBlockNetworksInfo(
+	blockNetworks, err := d.client.GetBlockNetworksInfo(ctx,
 		state.Space.ValueString(),
 		state.Block.ValueString(),
 		true,
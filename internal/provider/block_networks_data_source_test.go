@@ -33,6 +33,8 @@ func TestAccBlockNetworksDataSource(t *testing.T) {
 					// Verify first network to ensure all attributes are set
 					resource.TestCheckResourceAttr("data.azureipam_block_networks.test", "networks.0.id", "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/RG-WE-ALL-COMMS-01/providers/Microsoft.Network/virtualNetworks/vnet-we-a-testzavd-01"),
 					resource.TestCheckResourceAttr("data.azureipam_block_networks.test", "networks.0.name", "vnet-we-a-testzavd-01"),
+					resource.TestCheckResourceAttr("data.azureipam_block_networks.test", "networks.0.type", "vnet"),
+					resource.TestCheckNoResourceAttr("data.azureipam_block_networks.test", "networks.0.address_prefix"),
 					resource.TestCheckResourceAttr("data.azureipam_block_networks.test", "networks.0.prefixes.#", "1"),
 					resource.TestCheckResourceAttr("data.azureipam_block_networks.test", "networks.0.prefixes.0", "10.82.1.224/27"),
 					resource.TestCheckResourceAttr("data.azureipam_block_networks.test", "networks.0.resource_group", "rg-we-all-comms-01"),
@@ -51,3 +53,33 @@ func TestAccBlockNetworksDataSource(t *testing.T) {
 		},
 	})
 }
+
+func TestAccBlockNetworksDataSourceVirtualHub(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("GET", "https://mockedHost.azurewebsites.net/api/spaces/au/blocks/AustraliaEast/networks?expand=true",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/datasource/block_networks/block_networks_with_hub.json").String()), nil
+		})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccProviderConfig + `data "azureipam_block_networks" "test" {
+					space  = "au"
+					block  = "AustraliaEast"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.azureipam_block_networks.test", "networks.#", "3"),
+					// Verify the hub network
+					resource.TestCheckResourceAttr("data.azureipam_block_networks.test", "networks.2.id", "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/RG-WE-ALL-COMMS-01/providers/Microsoft.Network/virtualHubs/vhub-we-p-01"),
+					resource.TestCheckResourceAttr("data.azureipam_block_networks.test", "networks.2.name", "vhub-we-p-01"),
+					resource.TestCheckResourceAttr("data.azureipam_block_networks.test", "networks.2.type", "vhub"),
+					resource.TestCheckResourceAttr("data.azureipam_block_networks.test", "networks.2.address_prefix", "10.82.4.0/23"),
+				),
+			},
+		},
+	})
+}
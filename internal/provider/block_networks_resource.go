@@ -0,0 +1,449 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	ipamclient "terraform-provider-azureipam/ipamclient"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &blockNetworksResource{}
+	_ resource.ResourceWithConfigure   = &blockNetworksResource{}
+	_ resource.ResourceWithImportState = &blockNetworksResource{}
+)
+
+// NewBlockNetworksResource is a helper function to simplify the provider implementation.
+func NewBlockNetworksResource() resource.Resource {
+	return &blockNetworksResource{}
+}
+
+// blockNetworksResourceModel maps the resource schema data.
+type blockNetworksResourceModel struct {
+	Id       types.String        `tfsdk:"id"`
+	Space    types.String        `tfsdk:"space"`
+	Block    types.String        `tfsdk:"block"`
+	Ids      types.Set           `tfsdk:"ids"`
+	Networks []blockNetworkModel `tfsdk:"networks"`
+	Timeouts timeouts.Value      `tfsdk:"timeouts"`
+}
+
+// blockNetworksResource is the resource implementation.
+type blockNetworksResource struct {
+	client *ipamclient.Client
+}
+
+// Metadata returns the resource type name.
+func (r *blockNetworksResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_block_networks"
+}
+
+// Schema defines the schema for the resource.
+func (r *blockNetworksResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "The block_networks resource allows you to manage the full set of azure virtual networks and Virtual WAN hubs associated to the target block. The resource is authoritative, the networks associated outside terraform are removed from the block, and all the networks are disassociated when the resource is destroyed. It must not be combined with `azureipam_block_network` resources for the same block.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of the block networks, in the `{space}/{block}` format.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"space": schema.StringAttribute{
+				Description: "Name of the space where the networks must be associated. Changing this forces a new resource to be created.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"block": schema.StringAttribute{
+				Description: "Name of the block where the networks must be associated. Changing this forces a new resource to be created.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"ids": schema.SetAttribute{
+				Description: "The set of Azure Resource IDs of the virtual networks and Virtual WAN hubs to associate to the block. An empty set removes all the associations.",
+				Required:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(
+						stringvalidator.RegexMatches(blockNetworkIdRegex, "must be the Azure Resource ID of a virtual network or Virtual WAN hub"),
+					),
+				},
+			},
+			"networks": schema.ListNestedAttribute{
+				Description: "List containing the networks associated to the block.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "Azure Resource ID of the virtual network or Virtual WAN hub.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "Name of the Azure virtual network or Virtual WAN hub.",
+							Computed:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "Type of the network, `vnet` for virtual networks or `vhub` for Virtual WAN hubs.",
+							Computed:    true,
+						},
+						"prefixes": schema.ListAttribute{
+							Description: "The list of IPV4 prefixes assigned to this network, in cidr notation.",
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+						"address_prefix": schema.StringAttribute{
+							Description: "The IPV4 address prefix of the Virtual WAN hub, in cidr notation. Only set when `type` is `vhub`.",
+							Computed:    true,
+						},
+						"resource_group": schema.StringAttribute{
+							Description: "Name of the resource group where the network is deployed.",
+							Computed:    true,
+						},
+						"subscription_id": schema.StringAttribute{
+							Description: "Id of the Azure subscription where the network is deployed.",
+							Computed:    true,
+						},
+						"tenant_id": schema.StringAttribute{
+							Description: "Id of the Azure tenant where the network is deployed.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// Create a new resource.
+func (r *blockNetworksResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan blockNetworksResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	var ids []string
+	resp.Diagnostics.Append(plan.Ids.ElementsAs(ctx, &ids, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	//replace the existing associations, since the resource is authoritative
+	networks, err := r.client.ReplaceBlockNetworks(ctx,
+		plan.Space.ValueString(),
+		plan.Block.ValueString(),
+		ids,
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating block networks",
+			"Could not create block networks, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	//the engine may skip networks that it's unable to associate, so the missing ones are reported after saving the associated ones
+	missing := missingBlockNetworks(ids, networks)
+
+	// Map response body to schema and populate Computed attribute values
+	resp.Diagnostics.Append(flattenBlockNetworks(ctx, networks, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if len(missing) > 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("ids"),
+			"Error creating block networks",
+			"The IPAM engine didn't associate the following networks to the block "+plan.Id.ValueString()+": "+strings.Join(missing, ", ")+
+				". Check that the networks exist and that they are visible to the IPAM engine.",
+		)
+	}
+}
+
+// Read resource information.
+func (r *blockNetworksResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state blockNetworksResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	//read block networks
+	networks, err := r.client.GetBlockNetworksInfo(ctx,
+		state.Space.ValueString(),
+		state.Block.ValueString(),
+		true,
+	)
+	if ipamclient.IsNotFound(err) {
+		//block deleted outside terraform, remove from state to plan its recreation
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading AzureIpam block networks",
+			"Could not read AzureIpam block networks of block "+state.Id.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Overwrite items with refreshed state
+	resp.Diagnostics.Append(flattenBlockNetworks(ctx, networks, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update replaces the block networks with the planned ones.
+func (r *blockNetworksResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan blockNetworksResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	var ids []string
+	resp.Diagnostics.Append(plan.Ids.ElementsAs(ctx, &ids, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	//Replace the block networks
+	networks, err := r.client.ReplaceBlockNetworks(ctx,
+		plan.Space.ValueString(),
+		plan.Block.ValueString(),
+		ids,
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating block networks",
+			"Could not update block networks, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	//the engine may skip networks that it's unable to associate, so the missing ones are reported after saving the associated ones
+	missing := missingBlockNetworks(ids, networks)
+
+	// Map response body to schema and populate Computed attribute values
+	resp.Diagnostics.Append(flattenBlockNetworks(ctx, networks, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if len(missing) > 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("ids"),
+			"Error updating block networks",
+			"The IPAM engine didn't associate the following networks to the block "+plan.Id.ValueString()+": "+strings.Join(missing, ", ")+
+				". Check that the networks exist and that they are visible to the IPAM engine.",
+		)
+	}
+}
+
+func (r *blockNetworksResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state blockNetworksResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Disassociate all the networks
+	_, err := r.client.ReplaceBlockNetworks(ctx,
+		state.Space.ValueString(),
+		state.Block.ValueString(),
+		[]string{},
+	)
+	if err != nil && !ipamclient.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting AzureIpam block networks",
+			"Could not delete block networks, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *blockNetworksResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ipamclient.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *azureipam.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *blockNetworksResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID, validate, split and save to space and block attributes
+	re := regexp.MustCompile("^(?<space>[a-zA-Z0-9]+)/(?<block>[a-zA-Z0-9]+)$")
+
+	//validate
+	if !re.MatchString(req.ID) {
+		resp.Diagnostics.AddError(
+			"Error Importing AzureIpam block networks",
+			"The specified ID is not in the correct format {SpaceName}/{BlockName}.",
+		)
+		return
+	}
+	//extract values
+	matches := re.FindStringSubmatch(req.ID)
+	space := matches[re.SubexpIndex("space")]
+	block := matches[re.SubexpIndex("block")]
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("space"), space)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("block"), block)...)
+}
+
+func flattenBlockNetworks(ctx context.Context, networks *[]ipamclient.BlockNetworkInfo, model *blockNetworksResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	//the Azure Resource IDs are case insensitive, so the configured casing is kept to prevent false differences
+	var configured []string
+	if !model.Ids.IsNull() && !model.Ids.IsUnknown() {
+		diags.Append(model.Ids.ElementsAs(ctx, &configured, false)...)
+	}
+
+	model.Id = types.StringValue(model.Space.ValueString() + "/" + model.Block.ValueString())
+	model.Networks = []blockNetworkModel{}
+	ids := []attr.Value{}
+	for _, network := range *networks {
+		id := network.Id
+		for _, configuredId := range configured {
+			if strings.EqualFold(configuredId, id) {
+				id = configuredId
+				break
+			}
+		}
+		ids = append(ids, types.StringValue(id))
+
+		networkModel, networkDiags := flattenBlockNetworkInfo(ctx, &network)
+		diags.Append(networkDiags...)
+		model.Networks = append(model.Networks, networkModel)
+	}
+	var setDiags diag.Diagnostics
+	model.Ids, setDiags = types.SetValue(types.StringType, ids)
+	diags.Append(setDiags...)
+
+	return diags
+}
+
+// missingBlockNetworks returns the ids requested that are not in the networks associated to the block.
+func missingBlockNetworks(ids []string, networks *[]ipamclient.BlockNetworkInfo) []string {
+	missing := []string{}
+	for _, id := range ids {
+		found := false
+		for _, network := range *networks {
+			if strings.EqualFold(network.Id, id) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			missing = append(missing, id)
+		}
+	}
+	return missing
+}
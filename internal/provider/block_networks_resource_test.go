@@ -0,0 +1,242 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+	"testing"
+
+	ipamclient "terraform-provider-azureipam/ipamclient"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/jarcoal/httpmock"
+)
+
+const (
+	testAccBlockNetworksVnetA = "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/RG-WE-ALL-COMMS-01/providers/Microsoft.Network/virtualNetworks/vnet-we-a-testzavd-01"
+	testAccBlockNetworksVnetB = "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/RG-WE-ALL-COMMS-01/providers/Microsoft.Network/virtualNetworks/vnet-we-d-terratest-hub-01"
+	testAccBlockNetworksHub   = "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/RG-WE-ALL-COMMS-01/providers/Microsoft.Network/virtualHubs/vhub-we-p-01"
+)
+
+// registerBlockNetworksResponders mocks the block networks endpoints, storing the list of network ids associated to the block.
+// The details of the associated networks are taken from the networks of the block_networks_with_hub.json file.
+func registerBlockNetworksResponders(t *testing.T, associated *[]string) {
+	var inventory []ipamclient.BlockNetworkInfo
+	if err := json.Unmarshal([]byte(httpmock.File("tests/datasource/block_networks/block_networks_with_hub.json").String()), &inventory); err != nil {
+		t.Fatal(err)
+	}
+
+	httpmock.RegisterResponder("GET", "https://mockedHost.azurewebsites.net/api/spaces/au/blocks/AustraliaEast/networks?expand=true",
+		func(req *http.Request) (*http.Response, error) {
+			networks := []ipamclient.BlockNetworkInfo{}
+			for _, id := range *associated {
+				for _, network := range inventory {
+					if strings.EqualFold(network.Id, id) {
+						networks = append(networks, network)
+					}
+				}
+			}
+			return httpmock.NewJsonResponse(http.StatusOK, networks)
+		})
+	httpmock.RegisterResponder("PUT", "https://mockedHost.azurewebsites.net/api/spaces/au/blocks/AustraliaEast/networks",
+		func(req *http.Request) (*http.Response, error) {
+			body, err := io.ReadAll(req.Body)
+			if err != nil {
+				return nil, err
+			}
+			if err := json.Unmarshal(body, associated); err != nil || *associated == nil {
+				return httpmock.NewStringResponse(http.StatusBadRequest, `{"error":"Invalid request body."}`), nil
+			}
+			return httpmock.NewJsonResponse(http.StatusOK, *associated)
+		})
+}
+
+func TestAccBlockNetworksResource(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	associated := []string{testAccBlockNetworksVnetB}
+	registerBlockNetworksResponders(t, &associated)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing, replacing the existing association
+			{
+				Config: testAccProviderConfig + `resource "azureipam_block_networks" "test" {
+					space = "au"
+					block = "AustraliaEast"
+					ids = [
+						"` + testAccBlockNetworksVnetA + `",
+						"` + testAccBlockNetworksHub + `",
+					]
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					//Verify common attributes to ensure that all are set
+					resource.TestCheckResourceAttr("azureipam_block_networks.test", "id", "au/AustraliaEast"),
+					resource.TestCheckResourceAttr("azureipam_block_networks.test", "ids.#", "2"),
+					resource.TestCheckTypeSetElemAttr("azureipam_block_networks.test", "ids.*", testAccBlockNetworksVnetA),
+					resource.TestCheckTypeSetElemAttr("azureipam_block_networks.test", "ids.*", testAccBlockNetworksHub),
+					// Verify the networks details
+					resource.TestCheckResourceAttr("azureipam_block_networks.test", "networks.#", "2"),
+					resource.TestCheckResourceAttr("azureipam_block_networks.test", "networks.0.name", "vnet-we-a-testzavd-01"),
+					resource.TestCheckResourceAttr("azureipam_block_networks.test", "networks.0.type", "vnet"),
+					resource.TestCheckResourceAttr("azureipam_block_networks.test", "networks.1.name", "vhub-we-p-01"),
+					resource.TestCheckResourceAttr("azureipam_block_networks.test", "networks.1.type", "vhub"),
+					resource.TestCheckResourceAttr("azureipam_block_networks.test", "networks.1.address_prefix", "10.82.4.0/23"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "azureipam_block_networks.test",
+				ImportState:       true,
+				ImportStateId:     "au/AustraliaEast",
+				ImportStateVerify: true,
+			},
+			// ImportState testing with an incomplete ID
+			{
+				ResourceName:  "azureipam_block_networks.test",
+				ImportState:   true,
+				ImportStateId: "au/",
+				ExpectError:   regexp.MustCompile("The specified ID is not in the correct format"),
+			},
+			// Update and Read testing
+			{
+				Config: testAccProviderConfig + `resource "azureipam_block_networks" "test" {
+					space = "au"
+					block = "AustraliaEast"
+					ids = [
+						"` + testAccBlockNetworksVnetB + `",
+					]
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					//Verify attributes after update to ensure that all are set
+					resource.TestCheckResourceAttr("azureipam_block_networks.test", "ids.#", "1"),
+					resource.TestCheckTypeSetElemAttr("azureipam_block_networks.test", "ids.*", testAccBlockNetworksVnetB),
+					resource.TestCheckResourceAttr("azureipam_block_networks.test", "networks.#", "1"),
+					resource.TestCheckResourceAttr("azureipam_block_networks.test", "networks.0.name", "vnet-we-d-terratest-hub-01"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+		CheckDestroy: func(_ *terraform.State) error {
+			if len(associated) != 0 {
+				return fmt.Errorf("expected no block networks after destroy, got %v", associated)
+			}
+			return nil
+		},
+	})
+}
+
+func TestAccBlockNetworksResourceDrift(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	associated := []string{}
+	registerBlockNetworksResponders(t, &associated)
+
+	config := testAccProviderConfig + `resource "azureipam_block_networks" "test" {
+		space = "au"
+		block = "AustraliaEast"
+		ids   = ["` + testAccBlockNetworksVnetA + `"]
+	}`
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: config,
+				Check:  resource.TestCheckResourceAttr("azureipam_block_networks.test", "ids.#", "1"),
+			},
+			// Network id returned with a different casing, no changes must be planned
+			{
+				PreConfig: func() {
+					associated = []string{strings.ToLower(testAccBlockNetworksVnetA)}
+				},
+				Config:   config,
+				PlanOnly: true,
+			},
+			// Network associated outside terraform, its removal must be planned
+			{
+				PreConfig: func() {
+					associated = append(associated, testAccBlockNetworksVnetB)
+				},
+				Config:             config,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccBlockNetworksResourceNotAssociated(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	associated := []string{}
+	registerBlockNetworksResponders(t, &associated)
+
+	//the network is not in the mocked inventory, so the engine response omits it
+	unknownVnet := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/RG-WE-ALL-COMMS-01/providers/Microsoft.Network/virtualNetworks/vnet-we-unknown-01"
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create testing, the missing network must be reported
+			{
+				Config: testAccProviderConfig + `resource "azureipam_block_networks" "test" {
+					space = "au"
+					block = "AustraliaEast"
+					ids   = ["` + testAccBlockNetworksVnetA + `", "` + unknownVnet + `"]
+				}`,
+				ExpectError: regexp.MustCompile(`(?s)didn't associate the following networks to the block.*vnet-we-unknown-01`),
+			},
+			// Replacement of the tainted resource with the associated network only
+			{
+				Config: testAccProviderConfig + `resource "azureipam_block_networks" "test" {
+					space = "au"
+					block = "AustraliaEast"
+					ids   = ["` + testAccBlockNetworksVnetA + `"]
+				}`,
+				Check: resource.TestCheckResourceAttr("azureipam_block_networks.test", "ids.#", "1"),
+			},
+			// Update testing, the missing network must be reported
+			{
+				Config: testAccProviderConfig + `resource "azureipam_block_networks" "test" {
+					space = "au"
+					block = "AustraliaEast"
+					ids   = ["` + testAccBlockNetworksVnetA + `", "` + unknownVnet + `"]
+				}`,
+				ExpectError: regexp.MustCompile(`(?s)didn't associate the following networks to the block.*vnet-we-unknown-01`),
+			},
+		},
+	})
+}
+
+func TestMissingBlockNetworks(t *testing.T) {
+	networks := []ipamclient.BlockNetworkInfo{{Id: testAccBlockNetworksVnetA}, {Id: testAccBlockNetworksHub}}
+
+	//the Azure Resource IDs are compared ignoring the casing
+	if missing := missingBlockNetworks([]string{strings.ToUpper(testAccBlockNetworksVnetA), testAccBlockNetworksHub}, &networks); len(missing) != 0 {
+		t.Errorf("expected no missing networks, got %v", missing)
+	}
+	if missing := missingBlockNetworks([]string{testAccBlockNetworksVnetA, testAccBlockNetworksVnetB}, &networks); len(missing) != 1 || missing[0] != testAccBlockNetworksVnetB {
+		t.Errorf("expected %s to be missing, got %v", testAccBlockNetworksVnetB, missing)
+	}
+}
+
+func TestAccBlockNetworksResourceInvalidId(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig + `resource "azureipam_block_networks" "test" {
+					space = "au"
+					block = "AustraliaEast"
+					ids   = ["/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/RG-WE-ALL-COMMS-01"]
+				}`,
+				ExpectError: regexp.MustCompile("virtual network or Virtual WAN hub"),
+			},
+		},
+	})
+}
@@ -7,11 +7,13 @@ import (
 
 	ipamclient "terraform-provider-azureipam/ipamclient"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -29,9 +31,10 @@ func NewBlockResource() resource.Resource {
 
 // blockResourceModel maps the resource schema data.
 type blockResourceModel struct {
-	Name  types.String `tfsdk:"name"`
-	Space types.String `tfsdk:"space"`
-	Cidr  types.String `tfsdk:"cidr"`
+	Name     types.String   `tfsdk:"name"`
+	Space    types.String   `tfsdk:"space"`
+	Cidr     types.String   `tfsdk:"cidr"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 // blockResource is the resource implementation.
@@ -45,7 +48,7 @@ func (r *blockResource) Metadata(_ context.Context, req resource.MetadataRequest
 }
 
 // Schema defines the schema for the resource.
-func (r *blockResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *blockResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "The block resource allows you to create a IPAM block in a specific Space.",
 		Attributes: map[string]schema.Attribute{
@@ -61,10 +64,21 @@ func (r *blockResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				Required:    true,
 			},
 			"cidr": schema.StringAttribute{
-				Description: "The IP range to configure to the block, in cidr notation.",
+				Description: "The IP range to configure to the block, in cidr notation. Only IPv4 ranges with a prefix length between 8 and 29 are allowed, IPv6 ranges are rejected since they are not supported by the IPAM engine.",
 				Required:    true,
+				Validators: []validator.String{
+					ipv4CidrValidator(minPrefixLength, maxVnetPrefixLength),
+				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -78,7 +92,15 @@ func (r *blockResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	block, err := r.client.CreateBlock(
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	block, err := r.client.CreateBlock(ctx,
 		plan.Space.ValueString(),
 		plan.Name.ValueString(),
 		plan.Cidr.ValueString(),
@@ -112,13 +134,26 @@ func (r *blockResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	//read block
-	block, err := r.client.GetBlock(
+	block, err := r.client.GetBlock(ctx,
 		state.Space.ValueString(),
 		state.Name.ValueString(),
 		false,
 		false,
 	)
+	if ipamclient.IsNotFound(err) {
+		//deleted outside terraform, remove from state to plan its recreation
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading AzureIpam Block",
@@ -156,8 +191,16 @@ func (n *blockResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	//Modify the block resource
-	block, err := n.client.UpdateBlock(
+	block, err := n.client.UpdateBlock(ctx,
 		state.Space.ValueString(),
 		state.Name.ValueString(),
 		plan.Name.ValueStringPointer(),
@@ -191,8 +234,16 @@ func (r *blockResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	// Delete existing block
-	err := r.client.DeleteBlock(
+	err := r.client.DeleteBlock(ctx,
 		state.Space.ValueString(),
 		state.Name.ValueString(),
 		true,
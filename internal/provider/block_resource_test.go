@@ -2,6 +2,7 @@ package provider
 
 import (
 	"net/http"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -75,3 +76,51 @@ func TestAccBlockResource(t *testing.T) {
 		},
 	})
 }
+
+func TestAccBlockResourceInvalidCidr(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Not in cidr notation
+			{
+				Config: testAccProviderConfig + `resource "azureipam_block" "test" {
+					space = "au"
+					name = "AustraliaNorth"
+					cidr = "10.85.0.0"
+				}`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("is not a valid range in cidr notation"),
+			},
+			// Host bits set
+			{
+				Config: testAccProviderConfig + `resource "azureipam_block" "test" {
+					space = "au"
+					name = "AustraliaNorth"
+					cidr = "10.85.1.0/16"
+				}`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("has host bits set"),
+			},
+			// IPv6 range
+			{
+				Config: testAccProviderConfig + `resource "azureipam_block" "test" {
+					space = "au"
+					name = "AustraliaNorth"
+					cidr = "fd00::/48"
+				}`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("only IPv4 ranges are supported"),
+			},
+			// Prefix length out of bounds
+			{
+				Config: testAccProviderConfig + `resource "azureipam_block" "test" {
+					space = "au"
+					name = "AustraliaNorth"
+					cidr = "10.85.0.0/30"
+				}`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("must be between 8 and 29"),
+			},
+		},
+	})
+}
@@ -220,7 +220,7 @@ func (d *blocksDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	// Read Terraform configuration state into the model
 	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
 
-	blocks, err := d.client.GetBlocks(
+	blocks, err := d.client.GetBlocks(ctx,
 		state.Space.ValueString(),
 		state.Expand.ValueBool(),
 		state.AppendUtilization.ValueBool(),
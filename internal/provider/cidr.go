@@ -0,0 +1,131 @@
+package provider
+
+import (
+	"fmt"
+	"math/big"
+	"net/netip"
+	"strings"
+)
+
+//shared cidr functions
+
+// subnetRequest is a named subnet, of the given prefix length, to be allocated in a parent range.
+type subnetRequest struct {
+	Name string
+	Bits int
+}
+
+// parseRange parses an address range in cidr notation, or a single IP address, returning the network range
+// without the host bits.
+func parseRange(value string) (netip.Prefix, error) {
+	if !strings.Contains(value, "/") {
+		addr, err := netip.ParseAddr(value)
+		if err != nil {
+			return netip.Prefix{}, fmt.Errorf("%q is not a valid IP address or range in cidr notation", value)
+		}
+		return netip.PrefixFrom(addr, addr.BitLen()), nil
+	}
+
+	prefix, err := netip.ParsePrefix(value)
+	if err != nil {
+		return netip.Prefix{}, fmt.Errorf("%q is not a valid range in cidr notation", value)
+	}
+	return prefix.Masked(), nil
+}
+
+// cidrContains returns if the range, or address, is fully contained in the parent range.
+func cidrContains(parent netip.Prefix, child netip.Prefix) bool {
+	return parent.Addr().Is4() == child.Addr().Is4() && parent.Bits() <= child.Bits() && parent.Contains(child.Addr())
+}
+
+// cidrAddressCount returns the number of addresses of the range.
+func cidrAddressCount(prefix netip.Prefix) *big.Int {
+	return new(big.Int).Lsh(big.NewInt(1), uint(prefix.Addr().BitLen()-prefix.Bits()))
+}
+
+// cidrHostCount returns the number of addresses usable by hosts in the range. The network and broadcast
+// addresses are excluded in IPv4 ranges, except in the /31 and /32 ones that don't have them.
+func cidrHostCount(prefix netip.Prefix) *big.Int {
+	count := cidrAddressCount(prefix)
+	if prefix.Addr().Is4() && prefix.Bits() < 31 {
+		count.Sub(count, big.NewInt(2))
+	}
+	return count
+}
+
+// subnetAllocationError reports the requested subnet that could not be allocated.
+type subnetAllocationError struct {
+	Index   int
+	Message string
+}
+
+func (e *subnetAllocationError) Error() string {
+	return e.Message
+}
+
+// allocateSubnets packs the requested subnets in the parent range, in the order supplied. Each subnet is
+// allocated at the first free address aligned to its size, filling the gaps left by the previous alignments,
+// so the subnets are contiguous when they are requested from the largest to the smallest.
+func allocateSubnets(parent netip.Prefix, requests []subnetRequest) ([]netip.Prefix, error) {
+	bitLen := parent.Addr().BitLen()
+	start := new(big.Int).SetBytes(parent.Addr().AsSlice())
+	end := new(big.Int).Add(start, cidrAddressCount(parent))
+	free := cidrAddressCount(parent)
+
+	subnets := make([]netip.Prefix, 0, len(requests))
+	for i, request := range requests {
+		if request.Bits < parent.Bits() || request.Bits > bitLen {
+			return nil, &subnetAllocationError{
+				Index:   i,
+				Message: fmt.Sprintf("the prefix length of %s must be between %d and %d to fit in %s", request.Name, parent.Bits(), bitLen, parent),
+			}
+		}
+
+		//search the first aligned range that doesn't overlap the subnets already allocated
+		size := new(big.Int).Lsh(big.NewInt(1), uint(bitLen-request.Bits))
+		next := new(big.Int).Set(start)
+		var allocated *netip.Prefix
+		for new(big.Int).Add(next, size).Cmp(end) <= 0 {
+			candidate := netip.PrefixFrom(bigIntToAddr(next, bitLen), request.Bits)
+			conflict := -1
+			for j, subnet := range subnets {
+				if subnet.Overlaps(candidate) {
+					conflict = j
+					break
+				}
+			}
+			if conflict < 0 {
+				allocated = &candidate
+				break
+			}
+			//continue after the overlapped subnet, aligned to the requested size
+			next.SetBytes(subnets[conflict].Addr().AsSlice())
+			next.Add(next, cidrAddressCount(subnets[conflict]))
+			if remainder := new(big.Int).Mod(next, size); remainder.Sign() != 0 {
+				next.Add(next, new(big.Int).Sub(size, remainder))
+			}
+		}
+		if allocated == nil {
+			reason := fmt.Sprintf("only %s addresses are still free", free)
+			if free.Cmp(size) >= 0 {
+				reason = fmt.Sprintf("the %s addresses still free are not in an aligned /%d range", free, request.Bits)
+			}
+			return nil, &subnetAllocationError{
+				Index:   i,
+				Message: fmt.Sprintf("there is not enough space left in %s to allocate %s as a /%d, %s", parent, request.Name, request.Bits, reason),
+			}
+		}
+
+		subnets = append(subnets, *allocated)
+		free.Sub(free, size)
+	}
+
+	return subnets, nil
+}
+
+// bigIntToAddr converts the numeric value of an address to an IPv4 or IPv6 address.
+func bigIntToAddr(value *big.Int, bitLen int) netip.Addr {
+	bytes := value.FillBytes(make([]byte, bitLen/8))
+	addr, _ := netip.AddrFromSlice(bytes)
+	return addr
+}
@@ -0,0 +1,64 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var _ function.Function = &cidrContainsFunction{}
+
+// NewCidrContainsFunction is a helper function to simplify the provider implementation.
+func NewCidrContainsFunction() function.Function {
+	return &cidrContainsFunction{}
+}
+
+// cidrContainsFunction is the function implementation.
+type cidrContainsFunction struct{}
+
+// Metadata returns the function name.
+func (f *cidrContainsFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "cidr_contains"
+}
+
+// Definition defines the parameters and return type of the function.
+func (f *cidrContainsFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Checks if a range contains an IP address or another range",
+		Description: "Returns true when the IP address, or all the addresses of the range, are contained in the specified range, in cidr notation.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "cidr",
+				Description: "The containing range, in cidr notation.",
+			},
+			function.StringParameter{
+				Name:        "target",
+				Description: "The IP address, or the range in cidr notation, to check.",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+// Run executes the function logic.
+func (f *cidrContainsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var cidr, target string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &cidr, &target))
+	if resp.Error != nil {
+		return
+	}
+
+	parent, err := parseRange(cidr)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+	child, err := parseRange(target)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(1, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, cidrContains(parent, child)))
+}
@@ -0,0 +1,38 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccCidrContainsFunction(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig + `output "range" {
+					value = provider::azureipam::cidr_contains("10.0.0.0/16", "10.0.4.0/24")
+				}
+				output "address" {
+					value = provider::azureipam::cidr_contains("10.0.0.0/16", "10.1.0.1")
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("range", "true"),
+					resource.TestCheckOutput("address", "false"),
+				),
+			},
+			{
+				Config: testAccProviderConfig + `output "test" {
+					value = provider::azureipam::cidr_contains("10.0.0.0/16", "10.0.0.0/33")
+				}`,
+				ExpectError: regexp.MustCompile("not a valid range in cidr notation"),
+			},
+		},
+	})
+}
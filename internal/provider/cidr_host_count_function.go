@@ -0,0 +1,57 @@
+package provider
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var _ function.Function = &cidrHostCountFunction{}
+
+// NewCidrHostCountFunction is a helper function to simplify the provider implementation.
+func NewCidrHostCountFunction() function.Function {
+	return &cidrHostCountFunction{}
+}
+
+// cidrHostCountFunction is the function implementation.
+type cidrHostCountFunction struct{}
+
+// Metadata returns the function name.
+func (f *cidrHostCountFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "cidr_host_count"
+}
+
+// Definition defines the parameters and return type of the function.
+func (f *cidrHostCountFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Returns the number of host addresses of a range",
+		Description: "Returns the number of addresses usable by hosts in the range, in cidr notation. The network and broadcast addresses are excluded in IPv4 ranges, except in /31 and /32 ranges. Note that Azure reserves three more addresses in each subnet.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "cidr",
+				Description: "The range, in cidr notation.",
+			},
+		},
+		Return: function.NumberReturn{},
+	}
+}
+
+// Run executes the function logic.
+func (f *cidrHostCountFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var cidr string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &cidr))
+	if resp.Error != nil {
+		return
+	}
+
+	prefix, err := parseRange(cidr)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	count := new(big.Float).SetInt(cidrHostCount(prefix))
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, count))
+}
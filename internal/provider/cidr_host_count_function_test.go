@@ -0,0 +1,38 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccCidrHostCountFunction(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig + `output "subnet" {
+					value = provider::azureipam::cidr_host_count("10.0.0.0/24")
+				}
+				output "point_to_point" {
+					value = provider::azureipam::cidr_host_count("10.0.0.0/31")
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("subnet", "254"),
+					resource.TestCheckOutput("point_to_point", "2"),
+				),
+			},
+			{
+				Config: testAccProviderConfig + `output "test" {
+					value = provider::azureipam::cidr_host_count("10.0.0.300/24")
+				}`,
+				ExpectError: regexp.MustCompile("not a valid range in cidr notation"),
+			},
+		},
+	})
+}
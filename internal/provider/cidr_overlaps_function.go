@@ -0,0 +1,64 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var _ function.Function = &cidrOverlapsFunction{}
+
+// NewCidrOverlapsFunction is a helper function to simplify the provider implementation.
+func NewCidrOverlapsFunction() function.Function {
+	return &cidrOverlapsFunction{}
+}
+
+// cidrOverlapsFunction is the function implementation.
+type cidrOverlapsFunction struct{}
+
+// Metadata returns the function name.
+func (f *cidrOverlapsFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "cidr_overlaps"
+}
+
+// Definition defines the parameters and return type of the function.
+func (f *cidrOverlapsFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Checks if two ranges overlap",
+		Description: "Returns true when the two ranges, in cidr notation, have at least one address in common.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "cidr",
+				Description: "The first range, in cidr notation.",
+			},
+			function.StringParameter{
+				Name:        "other_cidr",
+				Description: "The second range, in cidr notation.",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+// Run executes the function logic.
+func (f *cidrOverlapsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var cidr, otherCidr string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &cidr, &otherCidr))
+	if resp.Error != nil {
+		return
+	}
+
+	first, err := parseRange(cidr)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+	second, err := parseRange(otherCidr)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(1, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, first.Overlaps(second)))
+}
@@ -0,0 +1,38 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccCidrOverlapsFunction(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig + `output "overlapped" {
+					value = provider::azureipam::cidr_overlaps("10.0.0.0/16", "10.0.128.0/17")
+				}
+				output "disjoint" {
+					value = provider::azureipam::cidr_overlaps("10.0.0.0/24", "10.0.1.0/24")
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("overlapped", "true"),
+					resource.TestCheckOutput("disjoint", "false"),
+				),
+			},
+			{
+				Config: testAccProviderConfig + `output "test" {
+					value = provider::azureipam::cidr_overlaps("10.0.0.0/16", "invalid")
+				}`,
+				ExpectError: regexp.MustCompile("not a valid IP address or range in cidr notation"),
+			},
+		},
+	})
+}
@@ -0,0 +1,87 @@
+package provider
+
+import (
+	"context"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var _ function.Function = &cidrSplitFunction{}
+
+// NewCidrSplitFunction is a helper function to simplify the provider implementation.
+func NewCidrSplitFunction() function.Function {
+	return &cidrSplitFunction{}
+}
+
+// cidrSplitFunction is the function implementation.
+type cidrSplitFunction struct{}
+
+// Metadata returns the function name.
+func (f *cidrSplitFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "cidr_split"
+}
+
+// Definition defines the parameters and return type of the function.
+func (f *cidrSplitFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Splits a range into named subnets",
+		Description: "Splits the range, in cidr notation, into the named subnets of the given prefix lengths, returning the range of each subnet by name. The subnets are allocated from the largest to the smallest, and by name when they are the same size, so they are contiguous from the start of the range.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "cidr",
+				Description: "The range to split, in cidr notation.",
+			},
+			function.MapParameter{
+				Name:        "subnets",
+				Description: "The prefix length of each subnet by name, e.g. `{ app = 26, data = 27 }`.",
+				ElementType: types.Int64Type,
+			},
+		},
+		Return: function.MapReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+// Run executes the function logic.
+func (f *cidrSplitFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var cidr string
+	var subnets map[string]int64
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &cidr, &subnets))
+	if resp.Error != nil {
+		return
+	}
+
+	parent, err := parseRange(cidr)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	//largest subnets first, so they are allocated without gaps
+	requests := make([]subnetRequest, 0, len(subnets))
+	for name, bits := range subnets {
+		requests = append(requests, subnetRequest{Name: name, Bits: int(bits)})
+	}
+	sort.Slice(requests, func(i, j int) bool {
+		if requests[i].Bits != requests[j].Bits {
+			return requests[i].Bits < requests[j].Bits
+		}
+		return requests[i].Name < requests[j].Name
+	})
+
+	allocated, err := allocateSubnets(parent, requests)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(1, err.Error())
+		return
+	}
+
+	result := make(map[string]string, len(allocated))
+	for i, subnet := range allocated {
+		result[requests[i].Name] = subnet.String()
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
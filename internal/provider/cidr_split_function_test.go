@@ -0,0 +1,41 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccCidrSplitFunction(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig + `output "test" {
+					value = provider::azureipam::cidr_split("10.0.0.0/24", { app = 26, data = 27, web = 25, gateway = 27 })
+				}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", knownvalue.MapExact(map[string]knownvalue.Check{
+						"web":     knownvalue.StringExact("10.0.0.0/25"),
+						"app":     knownvalue.StringExact("10.0.0.128/26"),
+						"data":    knownvalue.StringExact("10.0.0.192/27"),
+						"gateway": knownvalue.StringExact("10.0.0.224/27"),
+					})),
+				},
+			},
+			{
+				Config: testAccProviderConfig + `output "test" {
+					value = provider::azureipam::cidr_split("10.0.0.0/24", { app = 25, data = 25, web = 26 })
+				}`,
+				ExpectError: regexp.MustCompile("there is not enough space left in 10.0.0.0/24 to allocate web as a /26"),
+			},
+		},
+	})
+}
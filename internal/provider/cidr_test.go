@@ -0,0 +1,119 @@
+package provider
+
+import (
+	"errors"
+	"net/netip"
+	"strings"
+	"testing"
+)
+
+func TestAllocateSubnets(t *testing.T) {
+	tests := []struct {
+		name     string
+		parent   string
+		bits     []int
+		expected []string
+	}{
+		{
+			name:     "largest to smallest are contiguous",
+			parent:   "10.0.0.0/24",
+			bits:     []int{25, 26, 27, 28},
+			expected: []string{"10.0.0.0/25", "10.0.0.128/26", "10.0.0.192/27", "10.0.0.224/28"},
+		},
+		{
+			name:     "gaps left by the alignment are filled",
+			parent:   "10.0.0.0/24",
+			bits:     []int{28, 26, 28},
+			expected: []string{"10.0.0.0/28", "10.0.0.64/26", "10.0.0.16/28"},
+		},
+		{
+			name:     "the whole range",
+			parent:   "192.168.1.0/24",
+			bits:     []int{24},
+			expected: []string{"192.168.1.0/24"},
+		},
+		{
+			name:     "ipv6",
+			parent:   "fd00::/56",
+			bits:     []int{64, 64},
+			expected: []string{"fd00::/64", "fd00:0:0:1::/64"},
+		},
+	}
+	for _, test := range tests {
+		requests := make([]subnetRequest, 0, len(test.bits))
+		for i, bits := range test.bits {
+			requests = append(requests, subnetRequest{Name: string(rune('a' + i)), Bits: bits})
+		}
+
+		subnets, err := allocateSubnets(netip.MustParsePrefix(test.parent), requests)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+			continue
+		}
+		if len(subnets) != len(test.expected) {
+			t.Errorf("%s: expected %v, got %v", test.name, test.expected, subnets)
+			continue
+		}
+		for i, subnet := range subnets {
+			if subnet.String() != test.expected[i] {
+				t.Errorf("%s: expected %v, got %v", test.name, test.expected, subnets)
+				break
+			}
+		}
+	}
+}
+
+func TestAllocateSubnetsErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		parent  string
+		bits    []int
+		index   int
+		message string
+	}{
+		{
+			name:    "larger than the parent",
+			parent:  "10.0.0.0/24",
+			bits:    []int{23},
+			index:   0,
+			message: "must be between 24 and 32",
+		},
+		{
+			name:    "longer than the address",
+			parent:  "10.0.0.0/24",
+			bits:    []int{33},
+			index:   0,
+			message: "must be between 24 and 32",
+		},
+		{
+			name:    "not enough addresses",
+			parent:  "10.0.0.0/24",
+			bits:    []int{25, 25, 28},
+			index:   2,
+			message: "only 0 addresses are still free",
+		},
+		{
+			name:    "free addresses smaller than the subnet",
+			parent:  "10.0.0.0/24",
+			bits:    []int{26, 25, 25},
+			index:   2,
+			message: "only 64 addresses are still free",
+		},
+	}
+	for _, test := range tests {
+		requests := make([]subnetRequest, 0, len(test.bits))
+		for i, bits := range test.bits {
+			requests = append(requests, subnetRequest{Name: string(rune('a' + i)), Bits: bits})
+		}
+
+		_, err := allocateSubnets(netip.MustParsePrefix(test.parent), requests)
+		var allocationErr *subnetAllocationError
+		if !errors.As(err, &allocationErr) {
+			t.Errorf("%s: expected an allocation error, got %v", test.name, err)
+			continue
+		}
+		if allocationErr.Index != test.index || !strings.Contains(allocationErr.Message, test.message) {
+			t.Errorf("%s: expected %q for the request %d, got %q for the request %d", test.name, test.message, test.index, allocationErr.Message, allocationErr.Index)
+		}
+	}
+}
@@ -0,0 +1,99 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	ipamclient "terraform-provider-azureipam/ipamclient"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &exclusionsDataSource{}
+	_ datasource.DataSourceWithConfigure = &exclusionsDataSource{}
+)
+
+// NewExclusionsDataSource is a helper function to simplify the provider implementation.
+func NewExclusionsDataSource() datasource.DataSource {
+	return &exclusionsDataSource{}
+}
+
+// exclusionsDataSource is the data source implementation.
+type exclusionsDataSource struct {
+	client *ipamclient.Client
+}
+
+// exclusionsDataSourceModel maps the data source schema data.
+type exclusionsDataSourceModel struct {
+	SubscriptionIds []types.String `tfsdk:"subscription_ids"`
+}
+
+// Metadata returns the data source type name.
+func (d *exclusionsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_exclusions"
+}
+
+// Schema defines the schema for the data source.
+func (d *exclusionsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "The exclusions data source allows you to retrieve the list of subscriptions excluded from the IPAM discovery.",
+		Attributes: map[string]schema.Attribute{
+			"subscription_ids": schema.ListAttribute{
+				Description: "The list of the Azure subscription ids excluded from the IPAM discovery.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *exclusionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state exclusionsDataSourceModel
+
+	exclusions, err := d.client.GetExclusions(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read AzureIpam Exclusions",
+			err.Error(),
+		)
+		return
+	}
+
+	// Map response body to model
+	state.SubscriptionIds = []types.String{}
+	for _, subscriptionId := range *exclusions {
+		state.SubscriptionIds = append(state.SubscriptionIds, types.StringValue(subscriptionId))
+	}
+
+	// Set state
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *exclusionsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ipamclient.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *azureipam.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
@@ -0,0 +1,36 @@
+package provider
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/jarcoal/httpmock"
+)
+
+func TestAccExclusionsDataSource(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("GET", "https://mockedHost.azurewebsites.net/api/admin/exclusions",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/datasource/exclusions/exclusions.json").String()), nil
+		})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccProviderConfig + `data "azureipam_exclusions" "test" {
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify number of exclusions returned
+					resource.TestCheckResourceAttr("data.azureipam_exclusions.test", "subscription_ids.#", "2"),
+					// Verify the exclusions returned
+					resource.TestCheckResourceAttr("data.azureipam_exclusions.test", "subscription_ids.0", "6ba4c0d7-1e2f-4a3b-9c4d-5e6f7a8b9c0d"),
+					resource.TestCheckResourceAttr("data.azureipam_exclusions.test", "subscription_ids.1", "0f1e2d3c-4b5a-4978-8695-a4b3c2d1e0f9"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,317 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	ipamclient "terraform-provider-azureipam/ipamclient"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// exclusionsId is the fixed identifier of the exclusions resource, since there is only one list of exclusions in the IPAM application.
+const exclusionsId = "exclusions"
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &exclusionsResource{}
+	_ resource.ResourceWithConfigure   = &exclusionsResource{}
+	_ resource.ResourceWithImportState = &exclusionsResource{}
+)
+
+// NewExclusionsResource is a helper function to simplify the provider implementation.
+func NewExclusionsResource() resource.Resource {
+	return &exclusionsResource{}
+}
+
+// exclusionsResourceModel maps the resource schema data.
+type exclusionsResourceModel struct {
+	Id              types.String   `tfsdk:"id"`
+	SubscriptionIds types.Set      `tfsdk:"subscription_ids"`
+	Timeouts        timeouts.Value `tfsdk:"timeouts"`
+}
+
+// exclusionsResource is the resource implementation.
+type exclusionsResource struct {
+	client *ipamclient.Client
+}
+
+// Metadata returns the resource type name.
+func (r *exclusionsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_exclusions"
+}
+
+// Schema defines the schema for the resource.
+func (r *exclusionsResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "The exclusions resource allows you to manage the full list of subscriptions excluded from the IPAM discovery. The resource is authoritative, the subscriptions excluded outside terraform are removed from the list, and all the exclusions are removed when the resource is destroyed.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Fixed identifier of the exclusions, with the `exclusions` value.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"subscription_ids": schema.SetAttribute{
+				Description: "The set of the Azure subscription ids to exclude from the IPAM discovery. An empty set removes all the exclusions.",
+				Required:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(
+						stringvalidator.RegexMatches(uuidRegex, "must be an Azure subscription id, e.g. 00000000-0000-0000-0000-000000000000"),
+					),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// Create a new resource.
+func (r *exclusionsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan exclusionsResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	var subscriptionIds []string
+	resp.Diagnostics.Append(plan.SubscriptionIds.ElementsAs(ctx, &subscriptionIds, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	//replace the existing exclusions, since the resource is authoritative
+	exclusions, err := r.client.ReplaceExclusions(ctx, subscriptionIds)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating exclusions",
+			"Could not create exclusions, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	resp.Diagnostics.Append(flattenExclusions(ctx, exclusions, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read resource information.
+func (r *exclusionsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state exclusionsResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	//read exclusions
+	exclusions, err := r.client.GetExclusions(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading AzureIpam Exclusions",
+			"Could not read AzureIpam Exclusions: "+err.Error(),
+		)
+		return
+	}
+
+	// Overwrite items with refreshed state
+	resp.Diagnostics.Append(flattenExclusions(ctx, exclusions, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update replaces the exclusions with the planned ones.
+func (r *exclusionsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan exclusionsResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	var subscriptionIds []string
+	resp.Diagnostics.Append(plan.SubscriptionIds.ElementsAs(ctx, &subscriptionIds, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	//Replace the exclusions
+	exclusions, err := r.client.ReplaceExclusions(ctx, subscriptionIds)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating exclusions",
+			"Could not update exclusions, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	resp.Diagnostics.Append(flattenExclusions(ctx, exclusions, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *exclusionsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state exclusionsResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Remove all the exclusions
+	_, err := r.client.ReplaceExclusions(ctx, []string{})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting AzureIpam Exclusions",
+			"Could not delete exclusions, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *exclusionsResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ipamclient.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *azureipam.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *exclusionsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// There is only one list of exclusions, so the only valid import ID is the fixed identifier
+	if req.ID != exclusionsId {
+		resp.Diagnostics.AddError(
+			"Error Importing AzureIpam Exclusions",
+			"The specified ID must be the fixed value "+exclusionsId+".",
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), exclusionsId)...)
+}
+
+func flattenExclusions(ctx context.Context, exclusions *[]string, model *exclusionsResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	//the subscription ids are case insensitive, so the configured casing is kept to prevent false differences
+	var configured []string
+	if !model.SubscriptionIds.IsNull() && !model.SubscriptionIds.IsUnknown() {
+		diags.Append(model.SubscriptionIds.ElementsAs(ctx, &configured, false)...)
+	}
+
+	model.Id = types.StringValue(exclusionsId)
+	subscriptionIds := []attr.Value{}
+	if exclusions != nil {
+		for _, subscriptionId := range *exclusions {
+			for _, configuredId := range configured {
+				if strings.EqualFold(configuredId, subscriptionId) {
+					subscriptionId = configuredId
+					break
+				}
+			}
+			subscriptionIds = append(subscriptionIds, types.StringValue(subscriptionId))
+		}
+	}
+	var setDiags diag.Diagnostics
+	model.SubscriptionIds, setDiags = types.SetValue(types.StringType, subscriptionIds)
+	diags.Append(setDiags...)
+
+	return diags
+}
@@ -0,0 +1,187 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/jarcoal/httpmock"
+)
+
+// registerExclusionsResponders mocks the exclusions endpoints, storing the list of exclusions replaced.
+func registerExclusionsResponders(exclusions *[]string) {
+	httpmock.RegisterResponder("GET", "https://mockedHost.azurewebsites.net/api/admin/exclusions",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewJsonResponse(http.StatusOK, *exclusions)
+		})
+	httpmock.RegisterResponder("PUT", "https://mockedHost.azurewebsites.net/api/admin/exclusions",
+		func(req *http.Request) (*http.Response, error) {
+			body, err := io.ReadAll(req.Body)
+			if err != nil {
+				return nil, err
+			}
+			if err := json.Unmarshal(body, exclusions); err != nil {
+				return httpmock.NewStringResponse(http.StatusBadRequest, `{"error":"Invalid request body."}`), nil
+			}
+			return httpmock.NewJsonResponse(http.StatusOK, *exclusions)
+		})
+}
+
+func TestAccExclusionsResource(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	exclusions := []string{"9a4d6b1c-2e3f-4a5b-8c6d-7e8f9a0b1c2d"}
+	registerExclusionsResponders(&exclusions)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing, replacing the existing exclusion
+			{
+				Config: testAccProviderConfig + `resource "azureipam_exclusions" "test" {
+					subscription_ids = [
+						"6ba4c0d7-1e2f-4a3b-9c4d-5e6f7a8b9c0d",
+						"0f1e2d3c-4b5a-4978-8695-a4b3c2d1e0f9",
+					]
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					//Verify common attributes to ensure that all are set
+					resource.TestCheckResourceAttr("azureipam_exclusions.test", "id", "exclusions"),
+					resource.TestCheckResourceAttr("azureipam_exclusions.test", "subscription_ids.#", "2"),
+					resource.TestCheckTypeSetElemAttr("azureipam_exclusions.test", "subscription_ids.*", "6ba4c0d7-1e2f-4a3b-9c4d-5e6f7a8b9c0d"),
+					resource.TestCheckTypeSetElemAttr("azureipam_exclusions.test", "subscription_ids.*", "0f1e2d3c-4b5a-4978-8695-a4b3c2d1e0f9"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "azureipam_exclusions.test",
+				ImportState:       true,
+				ImportStateId:     "exclusions",
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccProviderConfig + `resource "azureipam_exclusions" "test" {
+					subscription_ids = [
+						"0f1e2d3c-4b5a-4978-8695-a4b3c2d1e0f9",
+					]
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					//Verify attributes after update to ensure that all are set
+					resource.TestCheckResourceAttr("azureipam_exclusions.test", "subscription_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttr("azureipam_exclusions.test", "subscription_ids.*", "0f1e2d3c-4b5a-4978-8695-a4b3c2d1e0f9"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+		CheckDestroy: func(_ *terraform.State) error {
+			if len(exclusions) != 0 {
+				return fmt.Errorf("expected no exclusions after destroy, got %v", exclusions)
+			}
+			return nil
+		},
+	})
+}
+
+func TestAccExclusionsResourceDrift(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	exclusions := []string{}
+	registerExclusionsResponders(&exclusions)
+
+	config := testAccProviderConfig + `resource "azureipam_exclusions" "test" {
+		subscription_ids = ["6ba4c0d7-1e2f-4a3b-9c4d-5e6f7a8b9c0d"]
+	}`
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: config,
+				Check:  resource.TestCheckResourceAttr("azureipam_exclusions.test", "subscription_ids.#", "1"),
+			},
+			// Subscription excluded outside terraform, its removal must be planned
+			{
+				PreConfig: func() {
+					exclusions = append(exclusions, "0f1e2d3c-4b5a-4978-8695-a4b3c2d1e0f9")
+				},
+				Config:             config,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccExclusionsResourceEmptyAndCasing(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	//the engine stores the subscription ids in lowercase and returns null when there are no exclusions
+	exclusions := []string{}
+	httpmock.RegisterResponder("GET", "https://mockedHost.azurewebsites.net/api/admin/exclusions",
+		func(req *http.Request) (*http.Response, error) {
+			if len(exclusions) == 0 {
+				return httpmock.NewStringResponse(http.StatusOK, "null"), nil
+			}
+			return httpmock.NewJsonResponse(http.StatusOK, exclusions)
+		})
+	httpmock.RegisterResponder("PUT", "https://mockedHost.azurewebsites.net/api/admin/exclusions",
+		func(req *http.Request) (*http.Response, error) {
+			body, err := io.ReadAll(req.Body)
+			if err != nil {
+				return nil, err
+			}
+			var replaced []string
+			if err := json.Unmarshal(body, &replaced); err != nil {
+				return httpmock.NewStringResponse(http.StatusBadRequest, `{"error":"Invalid request body."}`), nil
+			}
+			exclusions = []string{}
+			for _, subscriptionId := range replaced {
+				exclusions = append(exclusions, strings.ToLower(subscriptionId))
+			}
+			return httpmock.NewStringResponse(http.StatusOK, ""), nil
+		})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create with an empty set, the null response must not produce a difference
+			{
+				Config: testAccProviderConfig + `resource "azureipam_exclusions" "test" {
+					subscription_ids = []
+				}`,
+				Check: resource.TestCheckResourceAttr("azureipam_exclusions.test", "subscription_ids.#", "0"),
+			},
+			// Update with uppercase ids, the configured casing must be kept
+			{
+				Config: testAccProviderConfig + `resource "azureipam_exclusions" "test" {
+					subscription_ids = ["6BA4C0D7-1E2F-4A3B-9C4D-5E6F7A8B9C0D"]
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("azureipam_exclusions.test", "subscription_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttr("azureipam_exclusions.test", "subscription_ids.*", "6BA4C0D7-1E2F-4A3B-9C4D-5E6F7A8B9C0D"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccExclusionsResourceInvalidSubscriptionId(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig + `resource "azureipam_exclusions" "test" {
+					subscription_ids = ["sandbox"]
+				}`,
+				ExpectError: regexp.MustCompile("must be an Azure subscription id"),
+			},
+		},
+	})
+}
@@ -77,7 +77,7 @@ func (d *externalDataSource) Read(ctx context.Context, req datasource.ReadReques
 	// Read Terraform configuration state into the model
 	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
 
-	external, err := d.client.GetExternalInfo(
+	external, err := d.client.GetExternalInfo(ctx,
 		state.Space.ValueString(),
 		state.Block.ValueString(),
 		state.Name.ValueString(),
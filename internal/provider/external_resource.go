@@ -7,11 +7,13 @@ import (
 
 	ipamclient "terraform-provider-azureipam/ipamclient"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -29,11 +31,12 @@ func NewExternalResource() resource.Resource {
 
 // externalResourceModel maps the resource schema data.
 type externalResourceModel struct {
-	Space       types.String `tfsdk:"space"`
-	Block       types.String `tfsdk:"block"`
-	Name        types.String `tfsdk:"name"`
-	Description types.String `tfsdk:"description"`
-	Cidr        types.String `tfsdk:"cidr"`
+	Space       types.String   `tfsdk:"space"`
+	Block       types.String   `tfsdk:"block"`
+	Name        types.String   `tfsdk:"name"`
+	Description types.String   `tfsdk:"description"`
+	Cidr        types.String   `tfsdk:"cidr"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
 }
 
 // externalResource is the resource implementation.
@@ -47,7 +50,7 @@ func (r *externalResource) Metadata(_ context.Context, req resource.MetadataRequ
 }
 
 // Schema defines the schema for the resource.
-func (r *externalResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *externalResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "The external resource allows you to associate an external network to the target space and block.",
 		Attributes: map[string]schema.Attribute{
@@ -74,10 +77,21 @@ func (r *externalResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 				Required:    true,
 			},
 			"cidr": schema.StringAttribute{
-				Description: "The IP range to configure to the external network, in cidr notation.",
+				Description: "The IP range to configure to the external network, in cidr notation. Only IPv4 ranges with a prefix length between 8 and 32 are allowed, IPv6 ranges are rejected since they are not supported by the IPAM engine.",
 				Required:    true,
+				Validators: []validator.String{
+					ipv4CidrValidator(minPrefixLength, maxExternalPrefixLength),
+				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -91,7 +105,15 @@ func (r *externalResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
-	external, err := r.client.CreateExternal(
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	external, err := r.client.CreateExternal(ctx,
 		plan.Space.ValueString(),
 		plan.Block.ValueString(),
 		plan.Name.ValueString(),
@@ -127,12 +149,25 @@ func (r *externalResource) Read(ctx context.Context, req resource.ReadRequest, r
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	//read external
-	external, err := r.client.GetExternal(
+	external, err := r.client.GetExternal(ctx,
 		state.Space.ValueString(),
 		state.Block.ValueString(),
 		state.Name.ValueString(),
 	)
+	if ipamclient.IsNotFound(err) {
+		//deleted outside terraform, remove from state to plan its recreation
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading AzureIpam external network",
@@ -170,8 +205,16 @@ func (n *externalResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	//Modify the external resource
-	external, err := n.client.UpdateExternal(
+	external, err := n.client.UpdateExternal(ctx,
 		state.Space.ValueString(),
 		state.Block.ValueString(),
 		state.Name.ValueString(),
@@ -207,8 +250,16 @@ func (r *externalResource) Delete(ctx context.Context, req resource.DeleteReques
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	// Delete existing external network
-	err := r.client.DeleteExternal(
+	err := r.client.DeleteExternal(ctx,
 		state.Space.ValueString(),
 		state.Block.ValueString(),
 		state.Name.ValueString(),
@@ -2,6 +2,7 @@ package provider
 
 import (
 	"net/http"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -87,3 +88,70 @@ func TestAccExternalResource(t *testing.T) {
 		},
 	})
 }
+
+func TestAccExternalResourceDeletedOutsideTerraform(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	deleted := false
+	httpmock.RegisterResponder("POST", "https://mockedHost.azurewebsites.net/api/spaces/au/blocks/AustraliaSoutheast/externals",
+		func(req *http.Request) (*http.Response, error) {
+			deleted = false
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/resource/external/externals_with_new_external.json").String()), nil
+		})
+	httpmock.RegisterResponder("GET", "https://mockedHost.azurewebsites.net/api/spaces/au/blocks/AustraliaSoutheast/externals/acctest",
+		func(req *http.Request) (*http.Response, error) {
+			if deleted {
+				return httpmock.NewStringResponse(http.StatusBadRequest, `{"error":"Invalid external network name."}`), nil
+			}
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/resource/external/new_external.json").String()), nil
+		})
+	httpmock.RegisterResponder("DELETE", "https://mockedHost.azurewebsites.net/api/spaces/au/blocks/AustraliaSoutheast/externals/acctest",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, ""), nil
+		})
+
+	config := testAccProviderConfig + `resource "azureipam_external" "test" {
+		space = "au"
+		block = "AustraliaSoutheast"
+		name = "acctest"
+		description = "External Network for Acceptance Tests"
+		cidr = "10.83.1.0/24"
+	}`
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: config,
+				Check:  resource.TestCheckResourceAttr("azureipam_external.test", "name", "acctest"),
+			},
+			// Deleted outside terraform, recreation must be planned
+			{
+				PreConfig:          func() { deleted = true },
+				Config:             config,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccExternalResourceInvalidCidr(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Host bits set
+			{
+				Config: testAccProviderConfig + `resource "azureipam_external" "test" {
+					space = "au"
+					block = "AustraliaSoutheast"
+					name = "acctest"
+					description = "External Network for Acceptance Tests"
+					cidr = "10.83.1.1/24"
+				}`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("has host bits set"),
+			},
+		},
+	})
+}
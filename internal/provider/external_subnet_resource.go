@@ -0,0 +1,350 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	ipamclient "terraform-provider-azureipam/ipamclient"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &externalSubnetResource{}
+	_ resource.ResourceWithConfigure   = &externalSubnetResource{}
+	_ resource.ResourceWithImportState = &externalSubnetResource{}
+)
+
+// NewExternalSubnetResource is a helper function to simplify the provider implementation.
+func NewExternalSubnetResource() resource.Resource {
+	return &externalSubnetResource{}
+}
+
+// externalSubnetResourceModel maps the resource schema data.
+type externalSubnetResourceModel struct {
+	Space       types.String   `tfsdk:"space"`
+	Block       types.String   `tfsdk:"block"`
+	External    types.String   `tfsdk:"external"`
+	Name        types.String   `tfsdk:"name"`
+	Description types.String   `tfsdk:"description"`
+	Cidr        types.String   `tfsdk:"cidr"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
+}
+
+// externalSubnetResource is the resource implementation.
+type externalSubnetResource struct {
+	client *ipamclient.Client
+}
+
+// Metadata returns the resource type name.
+func (r *externalSubnetResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_external_subnet"
+}
+
+// Schema defines the schema for the resource.
+func (r *externalSubnetResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "The external subnet resource allows you to create a subnet in an external network of the target space and block.",
+		Attributes: map[string]schema.Attribute{
+			"space": schema.StringAttribute{
+				Description: "Name of the space of the external network. Changing this forces a new resource to be created.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"block": schema.StringAttribute{
+				Description: "Name of the block of the external network. Changing this forces a new resource to be created.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"external": schema.StringAttribute{
+				Description: "Name of the external network where the subnet must be created. Changing this forces a new resource to be created.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the external network subnet.",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "Text that describes the external network subnet.",
+				Required:    true,
+			},
+			"cidr": schema.StringAttribute{
+				Description: "The IP range to configure to the subnet, in cidr notation, that must be contained in the range of the external network. Only IPv4 ranges with a prefix length between 8 and 32 are allowed, IPv6 ranges are rejected since they are not supported by the IPAM engine.",
+				Required:    true,
+				Validators: []validator.String{
+					ipv4CidrValidator(minPrefixLength, maxExternalPrefixLength),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// Create a new resource.
+func (r *externalSubnetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan externalSubnetResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	subnet, err := r.client.CreateExternalSubnet(ctx,
+		plan.Space.ValueString(),
+		plan.Block.ValueString(),
+		plan.External.ValueString(),
+		plan.Name.ValueString(),
+		plan.Description.ValueString(),
+		plan.Cidr.ValueString(),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating external network subnet",
+			"Could not create external network subnet, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	flattenExternalSubnet(subnet, &plan)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read resource information.
+func (r *externalSubnetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state externalSubnetResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	//read external subnet
+	subnet, err := r.client.GetExternalSubnet(ctx,
+		state.Space.ValueString(),
+		state.Block.ValueString(),
+		state.External.ValueString(),
+		state.Name.ValueString(),
+	)
+	if ipamclient.IsNotFound(err) {
+		//deleted outside terraform, remove from state to plan its recreation
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading AzureIpam external network subnet",
+			"Could not read AzureIpam external network subnet with name "+state.Name.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Overwrite items with refreshed state
+	flattenExternalSubnet(subnet, &state)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update the name, description and cidr of the subnet.
+func (n *externalSubnetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve current state of the resource
+	var state externalSubnetResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Retrieve values from plan
+	var plan externalSubnetResourceModel
+	diags = req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	//Modify only the changed attributes of the subnet
+	var newName, newDescription, newCidr *string
+	if !plan.Name.Equal(state.Name) {
+		newName = plan.Name.ValueStringPointer()
+	}
+	if !plan.Description.Equal(state.Description) {
+		newDescription = plan.Description.ValueStringPointer()
+	}
+	if !plan.Cidr.Equal(state.Cidr) {
+		newCidr = plan.Cidr.ValueStringPointer()
+	}
+	subnet, err := n.client.UpdateExternalSubnet(ctx,
+		state.Space.ValueString(),
+		state.Block.ValueString(),
+		state.External.ValueString(),
+		state.Name.ValueString(),
+		newName,
+		newDescription,
+		newCidr,
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating external network subnet",
+			"Could not update external network subnet, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	flattenExternalSubnet(subnet, &plan)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *externalSubnetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state externalSubnetResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Delete existing external network subnet
+	err := r.client.DeleteExternalSubnet(ctx,
+		state.Space.ValueString(),
+		state.Block.ValueString(),
+		state.External.ValueString(),
+		state.Name.ValueString(),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting AzureIpam external network subnet",
+			"Could not delete external network subnet, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *externalSubnetResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ipamclient.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *azureipam.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *externalSubnetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID, validate, split and save to id attribute
+	re := regexp.MustCompile("^(?<space>[a-zA-Z0-9]*)/(?<block>[a-zA-Z0-9]*)/(?<external>[a-zA-Z0-9]*)/(?<name>[a-zA-Z0-9]*)$")
+	//validate
+	if !re.MatchString(req.ID) {
+		resp.Diagnostics.AddError(
+			"Error Importing AzureIpam external network subnet",
+			"The specified ID is not in the correct format {SpaceName}/{BlockName}/{ExternalNetworkName}/{SubnetName}.",
+		)
+		return
+	}
+	//extract values
+	matches := re.FindStringSubmatch(req.ID)
+	space := matches[re.SubexpIndex("space")]
+	block := matches[re.SubexpIndex("block")]
+	external := matches[re.SubexpIndex("external")]
+	name := matches[re.SubexpIndex("name")]
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("space"), space)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("block"), block)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("external"), external)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
+}
+
+func flattenExternalSubnet(subnet *ipamclient.ExternalSubnet, model *externalSubnetResourceModel) {
+	model.Space = types.StringValue(subnet.Space)
+	model.Block = types.StringValue(subnet.Block)
+	model.External = types.StringValue(subnet.External)
+	model.Name = types.StringValue(subnet.Name)
+	model.Description = types.StringValue(subnet.Description)
+	model.Cidr = types.StringValue(subnet.Cidr)
+}
@@ -0,0 +1,137 @@
+package provider
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/jarcoal/httpmock"
+)
+
+func TestAccExternalSubnetResource(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterMatcherResponder("POST", "https://mockedHost.azurewebsites.net/api/spaces/au/blocks/AustraliaSoutheast/externals/acctest/subnets",
+		httpmock.BodyContainsString(`"cidr":"10.83.1.0/26"`),
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusCreated, httpmock.File("tests/resource/external_subnet/new_external_subnet.json").String()), nil
+		})
+	httpmock.RegisterResponder("GET", "https://mockedHost.azurewebsites.net/api/spaces/au/blocks/AustraliaSoutheast/externals/acctest/subnets/acctestsubnet",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/resource/external_subnet/new_external_subnet.json").String()), nil
+		})
+	httpmock.RegisterMatcherResponder("PATCH", "https://mockedHost.azurewebsites.net/api/spaces/au/blocks/AustraliaSoutheast/externals/acctest/subnets/acctestsubnet",
+		httpmock.BodyContainsString(`{"op":"replace","path":"/cidr","value":"10.83.1.64/26"}`),
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/resource/external_subnet/updated_external_subnet.json").String()), nil
+		})
+	httpmock.RegisterResponder("GET", "https://mockedHost.azurewebsites.net/api/spaces/au/blocks/AustraliaSoutheast/externals/acctest/subnets/acctestsubnetupdated",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/resource/external_subnet/updated_external_subnet.json").String()), nil
+		})
+	httpmock.RegisterResponder("DELETE", "https://mockedHost.azurewebsites.net/api/spaces/au/blocks/AustraliaSoutheast/externals/acctest/subnets/acctestsubnetupdated",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, ""), nil
+		})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccProviderConfig + `resource "azureipam_external_subnet" "test" {
+					space = "au"
+					block = "AustraliaSoutheast"
+					external = "acctest"
+					name = "acctestsubnet"
+					description = "External Subnet for Acceptance Tests"
+					cidr = "10.83.1.0/26"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					//Verify common attributes to ensure that all are set
+					resource.TestCheckResourceAttr("azureipam_external_subnet.test", "space", "au"),
+					resource.TestCheckResourceAttr("azureipam_external_subnet.test", "block", "AustraliaSoutheast"),
+					resource.TestCheckResourceAttr("azureipam_external_subnet.test", "external", "acctest"),
+					resource.TestCheckResourceAttr("azureipam_external_subnet.test", "name", "acctestsubnet"),
+					resource.TestCheckResourceAttr("azureipam_external_subnet.test", "description", "External Subnet for Acceptance Tests"),
+					resource.TestCheckResourceAttr("azureipam_external_subnet.test", "cidr", "10.83.1.0/26"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         "azureipam_external_subnet.test",
+				ImportState:                          true,
+				ImportStateId:                        "au/AustraliaSoutheast/acctest/acctestsubnet",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "name",
+			},
+			// Update and Read testing
+			{
+				Config: testAccProviderConfig + `resource "azureipam_external_subnet" "test" {
+					space = "au"
+					block = "AustraliaSoutheast"
+					external = "acctest"
+					name = "acctestsubnetupdated"
+					description = "External Subnet for Acceptance Tests Updated"
+					cidr = "10.83.1.64/26"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					//Verify attributes after update to ensure that all are set
+					resource.TestCheckResourceAttr("azureipam_external_subnet.test", "external", "acctest"),
+					resource.TestCheckResourceAttr("azureipam_external_subnet.test", "name", "acctestsubnetupdated"),
+					resource.TestCheckResourceAttr("azureipam_external_subnet.test", "description", "External Subnet for Acceptance Tests Updated"),
+					resource.TestCheckResourceAttr("azureipam_external_subnet.test", "cidr", "10.83.1.64/26"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccExternalSubnetResourceDeletedOutsideTerraform(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	deleted := false
+	httpmock.RegisterResponder("POST", "https://mockedHost.azurewebsites.net/api/spaces/au/blocks/AustraliaSoutheast/externals/acctest/subnets",
+		func(req *http.Request) (*http.Response, error) {
+			deleted = false
+			return httpmock.NewStringResponse(http.StatusCreated, httpmock.File("tests/resource/external_subnet/new_external_subnet.json").String()), nil
+		})
+	httpmock.RegisterResponder("GET", "https://mockedHost.azurewebsites.net/api/spaces/au/blocks/AustraliaSoutheast/externals/acctest/subnets/acctestsubnet",
+		func(req *http.Request) (*http.Response, error) {
+			if deleted {
+				return httpmock.NewStringResponse(http.StatusBadRequest, `{"error":"Invalid subnet name."}`), nil
+			}
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/resource/external_subnet/new_external_subnet.json").String()), nil
+		})
+	httpmock.RegisterResponder("DELETE", "https://mockedHost.azurewebsites.net/api/spaces/au/blocks/AustraliaSoutheast/externals/acctest/subnets/acctestsubnet",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, ""), nil
+		})
+
+	config := testAccProviderConfig + `resource "azureipam_external_subnet" "test" {
+		space = "au"
+		block = "AustraliaSoutheast"
+		external = "acctest"
+		name = "acctestsubnet"
+		description = "External Subnet for Acceptance Tests"
+		cidr = "10.83.1.0/26"
+	}`
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: config,
+				Check:  resource.TestCheckResourceAttr("azureipam_external_subnet.test", "name", "acctestsubnet"),
+			},
+			// Deleted outside terraform, recreation must be planned
+			{
+				PreConfig:          func() { deleted = true },
+				Config:             config,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,136 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	ipamclient "terraform-provider-azureipam/ipamclient"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &externalSubnetsDataSource{}
+	_ datasource.DataSourceWithConfigure = &externalSubnetsDataSource{}
+)
+
+// NewExternalSubnetsDataSource is a helper function to simplify the provider implementation.
+func NewExternalSubnetsDataSource() datasource.DataSource {
+	return &externalSubnetsDataSource{}
+}
+
+// externalSubnetsDataSource is the data source implementation.
+type externalSubnetsDataSource struct {
+	client *ipamclient.Client
+}
+
+// externalSubnetsDataSourceModel maps the data source schema data.
+type externalSubnetsDataSourceModel struct {
+	Space    types.String          `tfsdk:"space"`
+	Block    types.String          `tfsdk:"block"`
+	External types.String          `tfsdk:"external"`
+	Subnets  []externalSubnetModel `tfsdk:"subnets"`
+}
+
+// Metadata returns the data source type name.
+func (d *externalSubnetsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_external_subnets"
+}
+
+// Schema defines the schema for the data source.
+func (d *externalSubnetsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "The external subnets data source allows you to retrieve information about all the subnets of an external network.",
+		Attributes: map[string]schema.Attribute{
+			"space": schema.StringAttribute{
+				Description: "Name of the space of the external network.",
+				Required:    true,
+			},
+			"block": schema.StringAttribute{
+				Description: "Name of the block of the external network.",
+				Required:    true,
+			},
+			"external": schema.StringAttribute{
+				Description: "Name of the external network for which to search the `subnets`.",
+				Required:    true,
+			},
+			"subnets": schema.ListNestedAttribute{
+				Description: "List containing the `subnets` found.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "Name of the external network subnet.",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "Text that describes the external network subnet.",
+							Computed:    true,
+						},
+						"cidr": schema.StringAttribute{
+							Description: "The IP range configured in the external network subnet, in cidr notation.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *externalSubnetsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state externalSubnetsDataSourceModel
+
+	// Read Terraform configuration state into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+
+	subnets, err := d.client.GetExternalSubnetsInfo(ctx,
+		state.Space.ValueString(),
+		state.Block.ValueString(),
+		state.External.ValueString(),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read AzureIpam External Network Subnets",
+			err.Error(),
+		)
+		return
+	}
+
+	// Map response body to model
+	state.Subnets = []externalSubnetModel{}
+	for _, subnet := range *subnets {
+		state.Subnets = append(state.Subnets, flattenExternalSubnetInfo(&subnet))
+	}
+
+	// Set state
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *externalSubnetsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ipamclient.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *azureipam.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
@@ -0,0 +1,49 @@
+package provider
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/jarcoal/httpmock"
+)
+
+func TestAccExternalSubnetsDataSource(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("GET", "https://mockedHost.azurewebsites.net/api/spaces/au/blocks/AustraliaSoutheast/externals/acctest/subnets",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/datasource/external_subnets/external_subnets_all.json").String()), nil
+		})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccProviderConfig + `data "azureipam_external_subnets" "test" {
+					space = "au"
+					block = "AustraliaSoutheast"
+					external = "acctest"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					//Verify common attributes to ensure that all are set
+					resource.TestCheckResourceAttr("data.azureipam_external_subnets.test", "space", "au"),
+					resource.TestCheckResourceAttr("data.azureipam_external_subnets.test", "block", "AustraliaSoutheast"),
+					resource.TestCheckResourceAttr("data.azureipam_external_subnets.test", "external", "acctest"),
+					// Verify number of subnets returned
+					resource.TestCheckResourceAttr("data.azureipam_external_subnets.test", "subnets.#", "2"),
+					// Verify the first subnet to ensure all attributes are set
+					resource.TestCheckResourceAttr("data.azureipam_external_subnets.test", "subnets.0.name", "frontend"),
+					resource.TestCheckResourceAttr("data.azureipam_external_subnets.test", "subnets.0.description", "Frontend subnet"),
+					resource.TestCheckResourceAttr("data.azureipam_external_subnets.test", "subnets.0.cidr", "10.83.1.0/26"),
+
+					// Verify the second subnet to ensure all attributes are set
+					resource.TestCheckResourceAttr("data.azureipam_external_subnets.test", "subnets.1.name", "backend"),
+					resource.TestCheckResourceAttr("data.azureipam_external_subnets.test", "subnets.1.description", "Backend subnet"),
+					resource.TestCheckResourceAttr("data.azureipam_external_subnets.test", "subnets.1.cidr", "10.83.1.64/26"),
+				),
+			},
+		},
+	})
+}
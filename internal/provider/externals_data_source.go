@@ -85,7 +85,7 @@ func (d *externalsDataSource) Read(ctx context.Context, req datasource.ReadReque
 	// Read Terraform configuration state into the model
 	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
 
-	externals, err := d.client.GetExternalsInfo(
+	externals, err := d.client.GetExternalsInfo(ctx,
 		state.Space.ValueString(),
 		state.Block.ValueString(),
 	)
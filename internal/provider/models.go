@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"strings"
 	ipamclient "terraform-provider-azureipam/ipamclient"
 	"time"
 
@@ -60,6 +61,13 @@ type externalModel struct {
 	Cidr        types.String `tfsdk:"cidr"`
 }
 
+// externalSubnetModel maps external subnet schema data.
+type externalSubnetModel struct {
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Cidr        types.String `tfsdk:"cidr"`
+}
+
 // reservationModel maps Reservation schema data.
 type reservationModel struct {
 	Id          types.String      `tfsdk:"id"`
@@ -76,7 +84,60 @@ type reservationModel struct {
 type blockNetworkModel struct {
 	Id             types.String `tfsdk:"id"`
 	Name           types.String `tfsdk:"name"`
+	Type           types.String `tfsdk:"type"`
 	Prefixes       types.List   `tfsdk:"prefixes"`
+	AddressPrefix  types.String `tfsdk:"address_prefix"`
+	ResourceGroup  types.String `tfsdk:"resource_group"`
+	SubscriptionId types.String `tfsdk:"subscription_id"`
+	TenantId       types.String `tfsdk:"tenant_id"`
+}
+
+// adminModel maps Admin schema data.
+type adminModel struct {
+	ObjectId types.String `tfsdk:"object_id"`
+	Type     types.String `tfsdk:"type"`
+	Name     types.String `tfsdk:"name"`
+	Email    types.String `tfsdk:"email"`
+}
+
+// azureVnetModel maps Azure vnet schema data.
+type azureVnetModel struct {
+	Name           types.String   `tfsdk:"name"`
+	Id             types.String   `tfsdk:"id"`
+	Prefixes       []types.String `tfsdk:"prefixes"`
+	Subnets        []subnetModel  `tfsdk:"subnets"`
+	ResourceGroup  types.String   `tfsdk:"resource_group"`
+	SubscriptionId types.String   `tfsdk:"subscription_id"`
+	TenantId       types.String   `tfsdk:"tenant_id"`
+	Size           types.Float64  `tfsdk:"size"`
+	Used           types.Float64  `tfsdk:"used"`
+	ParentSpace    types.String   `tfsdk:"parent_space"`
+	ParentBlock    types.String   `tfsdk:"parent_block"`
+}
+
+// azureSubnetModel maps Azure subnet schema data.
+type azureSubnetModel struct {
+	Name           types.String  `tfsdk:"name"`
+	Id             types.String  `tfsdk:"id"`
+	Prefix         types.String  `tfsdk:"prefix"`
+	VnetName       types.String  `tfsdk:"vnet_name"`
+	Type           types.String  `tfsdk:"type"`
+	ResourceGroup  types.String  `tfsdk:"resource_group"`
+	SubscriptionId types.String  `tfsdk:"subscription_id"`
+	TenantId       types.String  `tfsdk:"tenant_id"`
+	Size           types.Float64 `tfsdk:"size"`
+	Used           types.Float64 `tfsdk:"used"`
+}
+
+// azureEndpointModel maps Azure endpoint schema data.
+type azureEndpointModel struct {
+	Name           types.String `tfsdk:"name"`
+	Id             types.String `tfsdk:"id"`
+	PrivateIp      types.String `tfsdk:"private_ip"`
+	VnetName       types.String `tfsdk:"vnet_name"`
+	VnetId         types.String `tfsdk:"vnet_id"`
+	SubnetName     types.String `tfsdk:"subnet_name"`
+	SubnetId       types.String `tfsdk:"subnet_id"`
 	ResourceGroup  types.String `tfsdk:"resource_group"`
 	SubscriptionId types.String `tfsdk:"subscription_id"`
 	TenantId       types.String `tfsdk:"tenant_id"`
@@ -206,6 +267,16 @@ func flattenExternalInfo(external *ipamclient.ExternalInfo) externalModel {
 	return model
 }
 
+func flattenExternalSubnetInfo(subnet *ipamclient.ExternalSubnetInfo) externalSubnetModel {
+	var model externalSubnetModel
+
+	model.Name = types.StringValue(subnet.Name)
+	model.Description = types.StringValue(subnet.Description)
+	model.Cidr = types.StringValue(subnet.Cidr)
+
+	return model
+}
+
 func flattenReservationInfo(reservation *ipamclient.ReservationInfo) reservationModel {
 	var model reservationModel
 
@@ -236,10 +307,119 @@ func flattenBlockNetworkInfo(ctx context.Context, blockNetworks *ipamclient.Bloc
 
 	model.Id =  types.StringValue(blockNetworks.Id)
 	model.Name = types.StringValue(blockNetworks.Name) 
+	model.Type = types.StringValue(blockNetworks.Type)
 	model.Prefixes, diags = types.ListValueFrom(ctx, types.StringType, blockNetworks.Prefixes)
-	model.ResourceGroup= types.StringValue(*blockNetworks.ResourceGroup) 
-	model.SubscriptionId= types.StringValue(*blockNetworks.SubscriptionId) 
-	model.TenantId= types.StringValue(*blockNetworks.TenantId) 
+	model.AddressPrefix = types.StringPointerValue(blockNetworks.AddressPrefix)
+	model.ResourceGroup = types.StringPointerValue(blockNetworks.ResourceGroup)
+	model.SubscriptionId = types.StringPointerValue(blockNetworks.SubscriptionId)
+	model.TenantId = types.StringPointerValue(blockNetworks.TenantId)
 
 	return model,diags
+}
+
+func flattenAdminInfo(admin *ipamclient.Admin) adminModel {
+	var model adminModel
+
+	model.ObjectId = types.StringValue(admin.Id)
+	model.Type = types.StringValue(admin.Type)
+	model.Name = types.StringValue(admin.Name)
+	model.Email = types.StringPointerValue(admin.Email)
+
+	return model
+}
+
+func flattenAzureVnet(vnet *ipamclient.AzureVnet) azureVnetModel {
+	var model azureVnetModel
+
+	model.Name = types.StringValue(vnet.Name)
+	model.Id = types.StringValue(vnet.Id)
+	for _, prefix := range vnet.Prefixes {
+		model.Prefixes = append(model.Prefixes, types.StringValue(prefix))
+	}
+	for _, subnet := range vnet.Subnets {
+		model.Subnets = append(model.Subnets, flattenSubnetInfo(&subnet))
+	}
+	model.ResourceGroup = types.StringPointerValue(vnet.ResourceGroup)
+	model.SubscriptionId = types.StringPointerValue(vnet.SubscriptionId)
+	model.TenantId = types.StringPointerValue(vnet.TenantId)
+	model.Size = types.Float64PointerValue(vnet.Size)
+	model.Used = types.Float64PointerValue(vnet.Used)
+	model.ParentSpace = types.StringPointerValue(vnet.ParentSpace)
+	model.ParentBlock = types.StringPointerValue(vnet.ParentBlock)
+
+	return model
+}
+
+func flattenAzureSubnet(subnet *ipamclient.AzureSubnet) azureSubnetModel {
+	var model azureSubnetModel
+
+	model.Name = types.StringValue(subnet.Name)
+	model.Id = types.StringValue(subnet.Id)
+	model.Prefix = types.StringValue(subnet.Prefix)
+	model.VnetName = types.StringPointerValue(subnet.VnetName)
+	model.Type = types.StringPointerValue(subnet.Type)
+	model.ResourceGroup = types.StringPointerValue(subnet.ResourceGroup)
+	model.SubscriptionId = types.StringPointerValue(subnet.SubscriptionId)
+	model.TenantId = types.StringPointerValue(subnet.TenantId)
+	model.Size = types.Float64PointerValue(subnet.Size)
+	model.Used = types.Float64PointerValue(subnet.Used)
+
+	return model
+}
+
+func flattenAzureEndpoint(endpoint *ipamclient.AzureEndpoint) azureEndpointModel {
+	var model azureEndpointModel
+
+	model.Name = types.StringValue(endpoint.Name)
+	model.Id = types.StringValue(endpoint.Id)
+	model.PrivateIp = types.StringPointerValue(endpoint.PrivateIp)
+	model.VnetName = types.StringPointerValue(endpoint.VnetName)
+	model.VnetId = types.StringPointerValue(endpoint.VnetId)
+	model.SubnetName = types.StringPointerValue(endpoint.SubnetName)
+	model.SubnetId = types.StringPointerValue(endpoint.SubnetId)
+	model.ResourceGroup = types.StringPointerValue(endpoint.ResourceGroup)
+	model.SubscriptionId = types.StringPointerValue(endpoint.SubscriptionId)
+	model.TenantId = types.StringPointerValue(endpoint.TenantId)
+
+	return model
+}
+
+//shared filter functions
+
+// azureInventoryFilter filters the Azure resources discovered by IPAM by subscription, resource group and prefix.
+type azureInventoryFilter struct {
+	SubscriptionId types.String
+	ResourceGroup  types.String
+	Prefix         types.String
+}
+
+// matches returns if the resource, with the given subscription, resource group and ranges, satisfies all the
+// configured filters. The subscription and resource group are compared case-insensitively, as in Azure, and
+// the resource matches the prefix filter when any of its ranges is contained in the prefix.
+func (f azureInventoryFilter) matches(subscriptionId *string, resourceGroup *string, ranges []string) (bool, error) {
+	if !f.SubscriptionId.IsNull() && (subscriptionId == nil || !strings.EqualFold(*subscriptionId, f.SubscriptionId.ValueString())) {
+		return false, nil
+	}
+	if !f.ResourceGroup.IsNull() && (resourceGroup == nil || !strings.EqualFold(*resourceGroup, f.ResourceGroup.ValueString())) {
+		return false, nil
+	}
+	if f.Prefix.IsNull() {
+		return true, nil
+	}
+
+	parent, err := parseRange(f.Prefix.ValueString())
+	if err != nil {
+		return false, err
+	}
+	for _, value := range ranges {
+		child, err := parseRange(value)
+		if err != nil {
+			//ignore the ranges that are not valid
+			continue
+		}
+		if cidrContains(parent, child) {
+			return true, nil
+		}
+	}
+	return false, nil
 }
\ No newline at end of file
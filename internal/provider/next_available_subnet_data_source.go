@@ -0,0 +1,144 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	ipamclient "terraform-provider-azureipam/ipamclient"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &nextAvailableSubnetDataSource{}
+	_ datasource.DataSourceWithConfigure = &nextAvailableSubnetDataSource{}
+)
+
+// NewNextAvailableSubnetDataSource is a helper function to simplify the provider implementation.
+func NewNextAvailableSubnetDataSource() datasource.DataSource {
+	return &nextAvailableSubnetDataSource{}
+}
+
+// nextAvailableSubnetDataSource is the data source implementation.
+type nextAvailableSubnetDataSource struct {
+	client *ipamclient.Client
+}
+
+// nextAvailableSubnetDataSourceModel maps the data source schema data.
+type nextAvailableSubnetDataSourceModel struct {
+	VnetId         types.String `tfsdk:"vnet_id"`
+	Size           types.Int32  `tfsdk:"size"`
+	ReverseSearch  types.Bool   `tfsdk:"reverse_search"`
+	SmallestCidr   types.Bool   `tfsdk:"smallest_cidr"`
+	VnetName       types.String `tfsdk:"vnet_name"`
+	ResourceGroup  types.String `tfsdk:"resource_group"`
+	SubscriptionId types.String `tfsdk:"subscription_id"`
+	Cidr           types.String `tfsdk:"cidr"`
+}
+
+// Metadata returns the data source type name.
+func (d *nextAvailableSubnetDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_next_available_subnet"
+}
+
+// Schema defines the schema for the data source.
+func (d *nextAvailableSubnetDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "The next available subnet data source allows you to retrieve the next available cidr of the requested size in a virtual network tracked by IPAM, without creating the subnet. Useful to get a conflict-free prefix for a new subnet.",
+		Attributes: map[string]schema.Attribute{
+			"vnet_id": schema.StringAttribute{
+				Description: "Azure Resource ID of the virtual network in which to search for the next available cidr.",
+				Required:    true,
+			},
+			"size": schema.Int32Attribute{
+				Description: "Integer value to indicate the subnet mask bits, which defines the size of the subnet to search (example 26 for a /26 subnet).",
+				Required:    true,
+			},
+			"reverse_search": schema.BoolAttribute{
+				Description: "Search for the cidr as close to the end of the virtual network as possible?. Defaults to `false`.",
+				Optional:    true,
+			},
+			"smallest_cidr": schema.BoolAttribute{
+				Description: "Search for the cidr using the smallest possible available range? (e.g. it will not break up large CIDR ranges when possible). Defaults to `false`.",
+				Optional:    true,
+			},
+			"vnet_name": schema.StringAttribute{
+				Description: "Name of the Azure virtual network.",
+				Computed:    true,
+			},
+			"resource_group": schema.StringAttribute{
+				Description: "Name of the resource group where the `vnet` is deployed.",
+				Computed:    true,
+			},
+			"subscription_id": schema.StringAttribute{
+				Description: "Id of the Azure subscription where the `vnet` is deployed.",
+				Computed:    true,
+			},
+			"cidr": schema.StringAttribute{
+				Description: "The next available range, in cidr notation.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *nextAvailableSubnetDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state nextAvailableSubnetDataSourceModel
+
+	// Read Terraform configuration state into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	nextAvailable, err := d.client.GetNextAvailableSubnet(ctx,
+		state.VnetId.ValueString(),
+		state.Size.ValueInt32(),
+		state.ReverseSearch.ValueBool(),
+		state.SmallestCidr.ValueBool(),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read AzureIpam Next Available Subnet",
+			err.Error(),
+		)
+		return
+	}
+
+	// Map response body to model
+	state.VnetName = types.StringValue(nextAvailable.VnetName)
+	state.ResourceGroup = types.StringValue(nextAvailable.ResourceGroup)
+	state.SubscriptionId = types.StringValue(nextAvailable.SubscriptionId)
+	state.Cidr = types.StringValue(nextAvailable.Cidr)
+
+	// Set state
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *nextAvailableSubnetDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ipamclient.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *azureipam.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
@@ -0,0 +1,65 @@
+package provider
+
+import (
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/jarcoal/httpmock"
+)
+
+func TestAccNextAvailableSubnetDataSource(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterMatcherResponder("POST", "https://mockedHost.azurewebsites.net/api/tools/nextAvailableSubnet",
+		httpmock.BodyContainsString(`{"vnet_id":"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/RG-WE-ALL-COMMS-01/providers/Microsoft.Network/virtualNetworks/vnet-we-d-terratest-hub-01","size":26,"reverse_search":false,"smallest_cidr":true}`),
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/datasource/tools/next_available_subnet.json").String()), nil
+		})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccProviderConfig + `data "azureipam_next_available_subnet" "test" {
+					vnet_id       = "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/RG-WE-ALL-COMMS-01/providers/Microsoft.Network/virtualNetworks/vnet-we-d-terratest-hub-01"
+					size          = 26
+					smallest_cidr = true
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					//Verify common attributes to ensure that all are set
+					resource.TestCheckResourceAttr("data.azureipam_next_available_subnet.test", "size", "26"),
+					resource.TestCheckResourceAttr("data.azureipam_next_available_subnet.test", "vnet_name", "vnet-we-d-terratest-hub-01"),
+					resource.TestCheckResourceAttr("data.azureipam_next_available_subnet.test", "resource_group", "rg-we-all-comms-01"),
+					resource.TestCheckResourceAttr("data.azureipam_next_available_subnet.test", "subscription_id", "00000000-0000-0000-0000-000000000000"),
+					resource.TestCheckResourceAttr("data.azureipam_next_available_subnet.test", "cidr", "10.82.0.64/26"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNextAvailableSubnetDataSourceNoSpace(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("POST", "https://mockedHost.azurewebsites.net/api/tools/nextAvailableSubnet",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusInternalServerError, `{"error":"Subnet of requested size unavailable in target virtual network."}`), nil
+		})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccProviderConfig + `data "azureipam_next_available_subnet" "test" {
+					vnet_id = "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/RG-WE-ALL-COMMS-01/providers/Microsoft.Network/virtualNetworks/vnet-we-d-terratest-hub-01"
+					size    = 20
+				}`,
+				ExpectError: regexp.MustCompile("Subnet of requested size unavailable in target virtual network"),
+			},
+		},
+	})
+}
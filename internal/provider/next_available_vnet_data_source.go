@@ -0,0 +1,145 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	ipamclient "terraform-provider-azureipam/ipamclient"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &nextAvailableVnetDataSource{}
+	_ datasource.DataSourceWithConfigure = &nextAvailableVnetDataSource{}
+)
+
+// NewNextAvailableVnetDataSource is a helper function to simplify the provider implementation.
+func NewNextAvailableVnetDataSource() datasource.DataSource {
+	return &nextAvailableVnetDataSource{}
+}
+
+// nextAvailableVnetDataSource is the data source implementation.
+type nextAvailableVnetDataSource struct {
+	client *ipamclient.Client
+}
+
+// nextAvailableVnetDataSourceModel maps the data source schema data.
+type nextAvailableVnetDataSourceModel struct {
+	Space         types.String `tfsdk:"space"`
+	Blocks        types.List   `tfsdk:"blocks"`
+	Size          types.Int32  `tfsdk:"size"`
+	ReverseSearch types.Bool   `tfsdk:"reverse_search"`
+	SmallestCidr  types.Bool   `tfsdk:"smallest_cidr"`
+	Block         types.String `tfsdk:"block"`
+	Cidr          types.String `tfsdk:"cidr"`
+}
+
+// Metadata returns the data source type name.
+func (d *nextAvailableVnetDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_next_available_vnet"
+}
+
+// Schema defines the schema for the data source.
+func (d *nextAvailableVnetDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "The next available vnet data source allows you to retrieve the next available cidr of the requested size in a space and list of blocks, without reserving it. Useful to preview the cidr that a reservation would receive.",
+		Attributes: map[string]schema.Attribute{
+			"space": schema.StringAttribute{
+				Description: "Name of the existing space in the IPAM application.",
+				Required:    true,
+			},
+			"blocks": schema.ListAttribute{
+				Description: "List with the names of blocks in the specified space in which to search for the next available cidr. The list is evaluated in the order provided.",
+				Required:    true,
+				ElementType: types.StringType,
+			},
+			"size": schema.Int32Attribute{
+				Description: "Integer value to indicate the subnet mask bits, which defines the size of the vnet to search (example 24 for a /24 subnet).",
+				Required:    true,
+			},
+			"reverse_search": schema.BoolAttribute{
+				Description: "Search for the cidr as close to the end of the block as possible?. Defaults to `false`.",
+				Optional:    true,
+			},
+			"smallest_cidr": schema.BoolAttribute{
+				Description: "Search for the cidr using the smallest possible available block? (e.g. it will not break up large CIDR blocks when possible). Defaults to `false`.",
+				Optional:    true,
+			},
+			"block": schema.StringAttribute{
+				Description: "Name of the block where the next available cidr was found.",
+				Computed:    true,
+			},
+			"cidr": schema.StringAttribute{
+				Description: "The next available range, in cidr notation.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *nextAvailableVnetDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state nextAvailableVnetDataSourceModel
+
+	// Read Terraform configuration state into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var blocks []string
+	resp.Diagnostics.Append(state.Blocks.ElementsAs(ctx, &blocks, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	nextAvailable, err := d.client.GetNextAvailableVnet(ctx,
+		state.Space.ValueString(),
+		blocks,
+		state.Size.ValueInt32(),
+		state.ReverseSearch.ValueBool(),
+		state.SmallestCidr.ValueBool(),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read AzureIpam Next Available Vnet",
+			err.Error(),
+		)
+		return
+	}
+
+	// Map response body to model
+	state.Block = types.StringValue(nextAvailable.Block)
+	state.Cidr = types.StringValue(nextAvailable.Cidr)
+
+	// Set state
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *nextAvailableVnetDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ipamclient.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *azureipam.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
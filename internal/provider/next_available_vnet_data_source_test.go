@@ -0,0 +1,42 @@
+package provider
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/jarcoal/httpmock"
+)
+
+func TestAccNextAvailableVnetDataSource(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterMatcherResponder("POST", "https://mockedHost.azurewebsites.net/api/tools/nextAvailableVNet",
+		httpmock.BodyContainsString(`{"space":"au","blocks":["AustraliaEast","AustraliaSoutheast"],"size":24,"reverse_search":true,"smallest_cidr":false}`),
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/datasource/tools/next_available_vnet.json").String()), nil
+		})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccProviderConfig + `data "azureipam_next_available_vnet" "test" {
+					space          = "au"
+					blocks         = ["AustraliaEast", "AustraliaSoutheast"]
+					size           = 24
+					reverse_search = true
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					//Verify common attributes to ensure that all are set
+					resource.TestCheckResourceAttr("data.azureipam_next_available_vnet.test", "space", "au"),
+					resource.TestCheckResourceAttr("data.azureipam_next_available_vnet.test", "blocks.#", "2"),
+					resource.TestCheckResourceAttr("data.azureipam_next_available_vnet.test", "size", "24"),
+					resource.TestCheckResourceAttr("data.azureipam_next_available_vnet.test", "block", "AustraliaSoutheast"),
+					resource.TestCheckResourceAttr("data.azureipam_next_available_vnet.test", "cidr", "10.1.2.0/24"),
+				),
+			},
+		},
+	})
+}
@@ -2,7 +2,12 @@ package provider
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"net/http"
 	"os"
+	"strconv"
+	"time"
 
 	ipamclient "terraform-provider-azureipam/ipamclient"
 
@@ -22,6 +27,14 @@ var (
 	_ provider.ProviderWithFunctions = &azureIpamProvider{}
 )
 
+// Default timeouts of the resource operations, used when they are not configured in the resource timeouts block.
+const (
+	defaultCreateTimeout = 20 * time.Minute
+	defaultReadTimeout   = 5 * time.Minute
+	defaultUpdateTimeout = 20 * time.Minute
+	defaultDeleteTimeout = 20 * time.Minute
+)
+
 // NewAzureIpamProvider is a helper function to simplify provider server and testing implementation.
 func NewAzureIpamProvider(version string) func() provider.Provider {
 	return func() provider.Provider {
@@ -43,7 +56,23 @@ type azureIpamProvider struct {
 type azureIpamProviderModel struct {
 	ApiUrl                      types.String `tfsdk:"api_url"`
 	Token                       types.String `tfsdk:"token"`
+	EngineAppId                 types.String `tfsdk:"engine_app_id"`
+	TenantId                    types.String `tfsdk:"tenant_id"`
+	ClientId                    types.String `tfsdk:"client_id"`
+	ClientSecret                types.String `tfsdk:"client_secret"`
+	ClientCertificatePath       types.String `tfsdk:"client_certificate_path"`
+	ClientCertificatePassword   types.String `tfsdk:"client_certificate_password"`
+	OidcToken                   types.String `tfsdk:"oidc_token"`
+	OidcTokenFilePath           types.String `tfsdk:"oidc_token_file_path"`
+	UseMsi                      types.Bool   `tfsdk:"use_msi"`
+	MsiEndpoint                 types.String `tfsdk:"msi_endpoint"`
+	AuthorityHost               types.String `tfsdk:"authority_host"`
 	SkipCertificateVerification types.Bool   `tfsdk:"skip_cert_verification"`
+	RequestTimeout              types.Int64  `tfsdk:"request_timeout"`
+	MaxRetries                  types.Int64  `tfsdk:"max_retries"`
+	RetryMinWait                types.Int64  `tfsdk:"retry_min_wait"`
+	RetryMaxWait                types.Int64  `tfsdk:"retry_max_wait"`
+	RetryNonIdempotent          types.Bool   `tfsdk:"retry_non_idempotent"`
 }
 
 // Metadata returns the provider type name.
@@ -62,14 +91,81 @@ func (p *azureIpamProvider) Schema(ctx context.Context, req provider.SchemaReque
 				Optional:            true,
 			},
 			"token": schema.StringAttribute{
-				MarkdownDescription: "The bearer token to be used when authenticating to the API. Must be also assigned at AZUREIPAM_TOKEN environment variable.",
+				MarkdownDescription: "The bearer token to be used when authenticating to the API. Must be also assigned at AZUREIPAM_TOKEN environment variable. When specified, takes precedence over any other authentication method.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"engine_app_id": schema.StringAttribute{
+				MarkdownDescription: "The Application (client) ID of the IPAM Engine Microsoft Entra ID application, used to request access tokens for the `api://{engine_app_id}` audience. Required when `token` is not specified. Can also be assigned at AZUREIPAM_ENGINE_APP_ID environment variable.",
+				Optional:            true,
+			},
+			"tenant_id": schema.StringAttribute{
+				MarkdownDescription: "The Microsoft Entra ID tenant ID where the service principal used to authenticate is registered. Can also be assigned at AZUREIPAM_TENANT_ID environment variable.",
+				Optional:            true,
+			},
+			"client_id": schema.StringAttribute{
+				MarkdownDescription: "The client ID of the service principal, or of the user assigned managed identity, used to authenticate. Can also be assigned at AZUREIPAM_CLIENT_ID environment variable.",
+				Optional:            true,
+			},
+			"client_secret": schema.StringAttribute{
+				MarkdownDescription: "The client secret of the service principal used to authenticate. Can also be assigned at AZUREIPAM_CLIENT_SECRET environment variable.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"client_certificate_path": schema.StringAttribute{
+				MarkdownDescription: "The path to a PKCS#12 (.pfx) or PEM client certificate of the service principal used to authenticate, with its private key and optionally the certificate chain. Can also be assigned at AZUREIPAM_CLIENT_CERTIFICATE_PATH environment variable.",
+				Optional:            true,
+			},
+			"client_certificate_password": schema.StringAttribute{
+				MarkdownDescription: "The password of the client certificate specified in `client_certificate_path`. Can also be assigned at AZUREIPAM_CLIENT_CERTIFICATE_PASSWORD environment variable.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"oidc_token": schema.StringAttribute{
+				MarkdownDescription: "An OIDC token issued by an identity provider federated with the service principal (workload identity federation). Can also be assigned at AZUREIPAM_OIDC_TOKEN environment variable.",
 				Optional:            true,
 				Sensitive:           true,
 			},
+			"oidc_token_file_path": schema.StringAttribute{
+				MarkdownDescription: "The path to a file containing an OIDC token issued by an identity provider federated with the service principal (workload identity federation). Can also be assigned at AZUREIPAM_OIDC_TOKEN_FILE_PATH or AZURE_FEDERATED_TOKEN_FILE environment variables.",
+				Optional:            true,
+			},
+			"use_msi": schema.BoolAttribute{
+				MarkdownDescription: "Specifies if an Azure managed identity must be used to authenticate. Set `client_id` to use a user assigned identity. Can also be assigned at AZUREIPAM_USE_MSI environment variable. Default to false.",
+				Optional:            true,
+			},
+			"msi_endpoint": schema.StringAttribute{
+				MarkdownDescription: "The endpoint used to request managed identity tokens. Defaults to the App Service identity endpoint when available, otherwise to the Azure Instance Metadata Service. Can also be assigned at AZUREIPAM_MSI_ENDPOINT environment variable.",
+				Optional:            true,
+			},
+			"authority_host": schema.StringAttribute{
+				MarkdownDescription: "The Microsoft Entra ID authority host used to request access tokens. Can also be assigned at AZUREIPAM_AUTHORITY_HOST environment variable. Default to `https://login.microsoftonline.com`.",
+				Optional:            true,
+			},
 			"skip_cert_verification": schema.BoolAttribute{
 				MarkdownDescription: "Specifies it the certificate chain validation must be skipped calling the API endpoint. Default to false.",
 				Optional:            true,
 			},
+			"request_timeout": schema.Int64Attribute{
+				MarkdownDescription: "Seconds to wait for each API request to complete, including reading the response body, 0 to disable the limit. The whole operation is also limited by the `timeouts` block of each resource. Default to 30.",
+				Optional:            true,
+			},
+			"max_retries": schema.Int64Attribute{
+				MarkdownDescription: "Number of times a request is retried after a transient error (network errors and 429, 502, 503 or 504 status codes), 0 to disable retries. Default to 3.",
+				Optional:            true,
+			},
+			"retry_min_wait": schema.Int64Attribute{
+				MarkdownDescription: "Seconds to wait before the first retry, doubled on each subsequent retry with a random jitter. Default to 1.",
+				Optional:            true,
+			},
+			"retry_max_wait": schema.Int64Attribute{
+				MarkdownDescription: "Maximum seconds to wait between retries, also applied to the waits requested by the API with the `Retry-After` header. Default to 30.",
+				Optional:            true,
+			},
+			"retry_non_idempotent": schema.BoolAttribute{
+				MarkdownDescription: "Specifies if non idempotent requests (POST and PATCH) must be also retried, which may duplicate changes if the API had processed the failed request. Default to false.",
+				Optional:            true,
+			},
 		},
 	}
 }
@@ -103,6 +199,39 @@ func (p *azureIpamProvider) Configure(ctx context.Context, req provider.Configur
 				"Either target apply the source of the value first, set the value statically in the configuration, or use the AZUREIPAM_TOKEN environment variable.",
 		)
 	}
+	for _, attribute := range []struct {
+		name   string
+		envVar string
+		value  types.String
+	}{
+		{"engine_app_id", "AZUREIPAM_ENGINE_APP_ID", config.EngineAppId},
+		{"tenant_id", "AZUREIPAM_TENANT_ID", config.TenantId},
+		{"client_id", "AZUREIPAM_CLIENT_ID", config.ClientId},
+		{"client_secret", "AZUREIPAM_CLIENT_SECRET", config.ClientSecret},
+		{"client_certificate_path", "AZUREIPAM_CLIENT_CERTIFICATE_PATH", config.ClientCertificatePath},
+		{"client_certificate_password", "AZUREIPAM_CLIENT_CERTIFICATE_PASSWORD", config.ClientCertificatePassword},
+		{"oidc_token", "AZUREIPAM_OIDC_TOKEN", config.OidcToken},
+		{"oidc_token_file_path", "AZUREIPAM_OIDC_TOKEN_FILE_PATH", config.OidcTokenFilePath},
+		{"msi_endpoint", "AZUREIPAM_MSI_ENDPOINT", config.MsiEndpoint},
+		{"authority_host", "AZUREIPAM_AUTHORITY_HOST", config.AuthorityHost},
+	} {
+		if attribute.value.IsUnknown() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(attribute.name),
+				"Unknown AzureIpam authentication configuration",
+				"The provider cannot create the AzureIpam API client as there is an unknown configuration value for the "+attribute.name+" attribute. "+
+					"Either target apply the source of the value first, set the value statically in the configuration, or use the "+attribute.envVar+" environment variable.",
+			)
+		}
+	}
+	if config.UseMsi.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("use_msi"),
+			"Unknown AzureIpam authentication configuration",
+			"The provider cannot create the AzureIpam API client as there is an unknown configuration value for the use_msi attribute. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the AZUREIPAM_USE_MSI environment variable.",
+		)
+	}
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -117,6 +246,26 @@ func (p *azureIpamProvider) Configure(ctx context.Context, req provider.Configur
 	if !config.Token.IsNull() {
 		token = config.Token.ValueString()
 	}
+	auth := ipamclient.AuthConfig{
+		EngineAppId:               stringValueOrEnv(config.EngineAppId, "AZUREIPAM_ENGINE_APP_ID"),
+		TenantId:                  stringValueOrEnv(config.TenantId, "AZUREIPAM_TENANT_ID"),
+		ClientId:                  stringValueOrEnv(config.ClientId, "AZUREIPAM_CLIENT_ID"),
+		ClientSecret:              stringValueOrEnv(config.ClientSecret, "AZUREIPAM_CLIENT_SECRET"),
+		ClientCertificatePath:     stringValueOrEnv(config.ClientCertificatePath, "AZUREIPAM_CLIENT_CERTIFICATE_PATH"),
+		ClientCertificatePassword: stringValueOrEnv(config.ClientCertificatePassword, "AZUREIPAM_CLIENT_CERTIFICATE_PASSWORD"),
+		OidcToken:                 stringValueOrEnv(config.OidcToken, "AZUREIPAM_OIDC_TOKEN"),
+		OidcTokenFilePath:         stringValueOrEnv(config.OidcTokenFilePath, "AZUREIPAM_OIDC_TOKEN_FILE_PATH"),
+		MsiEndpoint:               stringValueOrEnv(config.MsiEndpoint, "AZUREIPAM_MSI_ENDPOINT"),
+		AuthorityHost:             stringValueOrEnv(config.AuthorityHost, "AZUREIPAM_AUTHORITY_HOST"),
+	}
+	if auth.OidcTokenFilePath == "" {
+		auth.OidcTokenFilePath = os.Getenv("AZURE_FEDERATED_TOKEN_FILE")
+	}
+	if config.UseMsi.IsNull() {
+		auth.UseMsi, _ = strconv.ParseBool(os.Getenv("AZUREIPAM_USE_MSI"))
+	} else {
+		auth.UseMsi = config.UseMsi.ValueBool()
+	}
 
 	// If any of the expected configurations are missing, return
 	// errors with provider-specific guidance.
@@ -129,12 +278,14 @@ func (p *azureIpamProvider) Configure(ctx context.Context, req provider.Configur
 				"If either is already set, ensure the value is not empty.",
 		)
 	}
-	if token == "" {
+	if token == "" && auth.EngineAppId == "" {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("token"),
-			"Missing AzureIpam API access token",
-			"The provider cannot create the AzureIpam API client as there is a missing or empty value for the AzureIpam API access token. "+
-				"Set the access token value in the configuration or use the AZUREIPAM_TOKEN environment variable. "+
+			"Missing AzureIpam API authentication",
+			"The provider cannot create the AzureIpam API client as there is a missing or empty value for the AzureIpam API access token, "+
+				"and no IPAM Engine application id has been provided to request it from Microsoft Entra ID. "+
+				"Set the access token value in the configuration or use the AZUREIPAM_TOKEN environment variable, "+
+				"or set the engine_app_id value with one of the supported authentication methods. "+
 				"If either is already set, ensure the value is not empty.",
 		)
 	}
@@ -151,14 +302,39 @@ func (p *azureIpamProvider) Configure(ctx context.Context, req provider.Configur
 		skipCertVerification = config.SkipCertificateVerification.ValueBool()
 	}
 
+	for _, attribute := range []struct {
+		name  string
+		value types.Int64
+	}{
+		{"request_timeout", config.RequestTimeout},
+		{"max_retries", config.MaxRetries},
+		{"retry_min_wait", config.RetryMinWait},
+		{"retry_max_wait", config.RetryMaxWait},
+	} {
+		if attribute.value.ValueInt64() < 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(attribute.name),
+				"Invalid AzureIpam client configuration",
+				"The provider cannot create the AzureIpam API client as the "+attribute.name+" value must be zero or a positive number.",
+			)
+		}
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	ctx = tflog.SetField(ctx, "azureipam_api_url", apiUrl)
 	ctx = tflog.SetField(ctx, "azureipam_token", token)
+	ctx = tflog.SetField(ctx, "azureipam_engine_app_id", auth.EngineAppId)
+	ctx = tflog.SetField(ctx, "azureipam_tenant_id", auth.TenantId)
+	ctx = tflog.SetField(ctx, "azureipam_client_id", auth.ClientId)
+	ctx = tflog.SetField(ctx, "azureipam_use_msi", auth.UseMsi)
 	ctx = tflog.SetField(ctx, "azureipam_skip_cert_verification", skipCertVerification)
 	ctx = tflog.MaskFieldValuesWithFieldKeys(ctx, "azureipam_token")
 
 	tflog.Debug(ctx, "Creating AzureIpam client")
 	// Create a new AzureIpam client using the configuration values
-	client, err := ipamclient.NewClient(&apiUrl, &token, skipCertVerification)
+	client, err := ipamclient.NewClient(&apiUrl, &token, &auth, skipCertVerification)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Create AzureIpam API Client",
@@ -169,6 +345,61 @@ func (p *azureIpamProvider) Configure(ctx context.Context, req provider.Configur
 		return
 	}
 
+	// Override the default request timeout and retry policy, if configured
+	if !config.RequestTimeout.IsNull() {
+		client.HTTPClient.Timeout = time.Duration(config.RequestTimeout.ValueInt64()) * time.Second
+	}
+	if !config.MaxRetries.IsNull() {
+		client.MaxRetries = int(config.MaxRetries.ValueInt64())
+	}
+	if !config.RetryMinWait.IsNull() {
+		client.RetryMinWait = time.Duration(config.RetryMinWait.ValueInt64()) * time.Second
+	}
+	if !config.RetryMaxWait.IsNull() {
+		client.RetryMaxWait = time.Duration(config.RetryMaxWait.ValueInt64()) * time.Second
+	}
+	client.RetryNonIdempotent = config.RetryNonIdempotent.ValueBool()
+
+	// Check the engine version, the configuration continues with a warning if the status can't be read,
+	// but an engine without the status endpoint, or reporting an older version, is not supported.
+	status, err := client.GetStatus(ctx)
+	var apiErr *ipamclient.APIError
+	if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("api_url"),
+			"AzureIpam Engine Status Not Found",
+			fmt.Sprintf("The AzureIpam engine status was not found at %s/api/status. "+
+				"Please check that the api_url is the root url of the IPAM engine, without the /api suffix. "+
+				"If the url is right, the engine is older than the version %s required by the provider, "+
+				"so please upgrade your Azure IPAM implementation, see https://github.com/Azure/ipam for the upgrade instructions.",
+				apiUrl, ipamclient.MinEngineVersion),
+		)
+		return
+	} else if err != nil {
+		resp.Diagnostics.AddWarning(
+			"Unable to Check the AzureIpam Engine Version",
+			"The AzureIpam engine status could not be read, so the engine version can't be checked. "+
+				"The provider requires the version "+ipamclient.MinEngineVersion+" or later, older engines can fail with unexpected errors.\n\n"+
+				"AzureIpam Client Error: "+err.Error(),
+		)
+	} else if cmp, err := ipamclient.CompareEngineVersions(status.Version, ipamclient.MinEngineVersion); err != nil {
+		resp.Diagnostics.AddWarning(
+			"Unable to Check the AzureIpam Engine Version",
+			fmt.Sprintf("The AzureIpam engine reports the version %q, that can't be compared with the version %s required by the provider. "+
+				"Older engines can fail with unexpected errors.", status.Version, ipamclient.MinEngineVersion),
+		)
+	} else if cmp < 0 {
+		resp.Diagnostics.AddError(
+			"Unsupported AzureIpam Engine Version",
+			fmt.Sprintf("The AzureIpam engine at %s reports the version %s, but the provider requires the version %s or later. "+
+				"Please upgrade your Azure IPAM implementation, see https://github.com/Azure/ipam for the upgrade instructions.",
+				apiUrl, status.Version, ipamclient.MinEngineVersion),
+		)
+		return
+	} else {
+		tflog.Debug(ctx, "AzureIpam engine version checked", map[string]any{"version": status.Version})
+	}
+
 	// Make the AzureIpam client available during DataSource and Resource
 	// type Configure methods.
 	resp.DataSourceData = client
@@ -188,8 +419,18 @@ func (p *azureIpamProvider) DataSources(ctx context.Context) []func() datasource
 		NewBlockDataSource,
 		NewExternalsDataSource,
 		NewExternalDataSource,
+		NewExternalSubnetsDataSource,
 		NewBlockNetworksDataSource,
 		NewBlockNetworksAvailablesDataSource,
+		NewNextAvailableVnetDataSource,
+		NewNextAvailableSubnetDataSource,
+		NewSubnetPlanDataSource,
+		NewExclusionsDataSource,
+		NewAdminsDataSource,
+		NewAzureVnetsDataSource,
+		NewAzureSubnetsDataSource,
+		NewAzureEndpointsDataSource,
+		NewStatusDataSource,
 	}
 }
 
@@ -202,9 +443,28 @@ func (p *azureIpamProvider) Resources(ctx context.Context) []func() resource.Res
 		NewExternalResource,
 		NewReservationCidrResource,
 		NewBlockNetworkResource,
+		NewReservationSettlementResource,
+		NewExternalSubnetResource,
+		NewExclusionsResource,
+		NewAdminResource,
+		NewBlockNetworksResource,
+		NewBlockExternalsResource,
 	}
 }
 
 func (p *azureIpamProvider) Functions(ctx context.Context) []func() function.Function {
-	return []func() function.Function{}
+	return []func() function.Function{
+		NewCidrContainsFunction,
+		NewCidrOverlapsFunction,
+		NewCidrHostCountFunction,
+		NewCidrSplitFunction,
+	}
+}
+
+// stringValueOrEnv returns the configured value, or the environment variable value when not set.
+func stringValueOrEnv(value types.String, envVar string) string {
+	if value.IsNull() {
+		return os.Getenv(envVar)
+	}
+	return value.ValueString()
 }
@@ -1,8 +1,28 @@
 package provider
 
 import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/jarcoal/httpmock"
 )
 
 const (
@@ -26,4 +46,461 @@ var (
 	testAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
 		"azureipam": providerserver.NewProtocol6WithError(NewAzureIpamProvider("test")()),
 	}
-)
\ No newline at end of file
+)
+
+func TestAccProviderClientSecretAuthentication(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("POST", "https://login.mocked.local/22222222-2222-2222-2222-222222222222/oauth2/v2.0/token",
+		func(req *http.Request) (*http.Response, error) {
+			if err := req.ParseForm(); err != nil {
+				return nil, err
+			}
+			if req.PostForm.Get("grant_type") != "client_credentials" ||
+				req.PostForm.Get("client_id") != "33333333-3333-3333-3333-333333333333" ||
+				req.PostForm.Get("client_secret") != "dummySecretForTesting" ||
+				req.PostForm.Get("scope") != "api://44444444-4444-4444-4444-444444444444/.default" {
+				return httpmock.NewStringResponse(http.StatusUnauthorized, `{"error":"invalid_client","error_description":"unexpected token request"}`), nil
+			}
+			return httpmock.NewStringResponse(http.StatusOK, `{"token_type":"Bearer","expires_in":3599,"access_token":"mockedClientSecretToken"}`), nil
+		})
+	httpmock.RegisterResponder("GET", "https://mockedHost.azurewebsites.net/api/spaces/au?expand=false&utilization=false",
+		func(req *http.Request) (*http.Response, error) {
+			if req.Header.Get("Authorization") != "Bearer mockedClientSecretToken" {
+				return httpmock.NewStringResponse(http.StatusUnauthorized, `{"error":"invalid token"}`), nil
+			}
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/datasource/spaces/space_without_utilization_and_vnet.json").String()), nil
+		})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: `provider "azureipam" {
+					api_url        = "https://mockedHost.azurewebsites.net"
+					authority_host = "https://login.mocked.local"
+					engine_app_id  = "44444444-4444-4444-4444-444444444444"
+					tenant_id      = "22222222-2222-2222-2222-222222222222"
+					client_id      = "33333333-3333-3333-3333-333333333333"
+					client_secret  = "dummySecretForTesting"
+				}
+				data "azureipam_space" "test" {
+					name = "au"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.azureipam_space.test", "name", "au"),
+					resource.TestCheckResourceAttr("data.azureipam_space.test", "description", "Australia"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccProviderManagedIdentityAuthentication(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("GET", "http://msi.mocked.local/metadata/identity/oauth2/token",
+		func(req *http.Request) (*http.Response, error) {
+			if req.Header.Get("Metadata") != "true" ||
+				req.URL.Query().Get("resource") != "api://44444444-4444-4444-4444-444444444444" ||
+				req.URL.Query().Get("client_id") != "33333333-3333-3333-3333-333333333333" {
+				return httpmock.NewStringResponse(http.StatusBadRequest, `{"error":"invalid_request","error_description":"unexpected token request"}`), nil
+			}
+			return httpmock.NewStringResponse(http.StatusOK, `{"token_type":"Bearer","expires_on":"4102444800","access_token":"mockedManagedIdentityToken"}`), nil
+		})
+	httpmock.RegisterResponder("GET", "https://mockedHost.azurewebsites.net/api/spaces/au?expand=false&utilization=false",
+		func(req *http.Request) (*http.Response, error) {
+			if req.Header.Get("Authorization") != "Bearer mockedManagedIdentityToken" {
+				return httpmock.NewStringResponse(http.StatusUnauthorized, `{"error":"invalid token"}`), nil
+			}
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/datasource/spaces/space_without_utilization_and_vnet.json").String()), nil
+		})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: `provider "azureipam" {
+					api_url       = "https://mockedHost.azurewebsites.net"
+					engine_app_id = "44444444-4444-4444-4444-444444444444"
+					client_id     = "33333333-3333-3333-3333-333333333333"
+					use_msi       = true
+					msi_endpoint  = "http://msi.mocked.local/metadata/identity/oauth2/token"
+				}
+				data "azureipam_space" "test" {
+					name = "au"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.azureipam_space.test", "name", "au"),
+					resource.TestCheckResourceAttr("data.azureipam_space.test", "description", "Australia"),
+				),
+			},
+		},
+	})
+}
+
+// writeTestClientCertificate writes a self-signed client certificate, with its private key, to a PEM file.
+func writeTestClientCertificate(t *testing.T) (string, *x509.Certificate) {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("unable to generate the key: %v", err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "sp-terraform-ipam"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("unable to create the certificate: %v", err)
+	}
+	cert, _ := x509.ParseCertificate(der)
+	keyDer, _ := x509.MarshalPKCS8PrivateKey(key)
+
+	path := filepath.Join(t.TempDir(), "client.pem")
+	content := append(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyDer})...)
+	if err := os.WriteFile(path, content, 0600); err != nil {
+		t.Fatalf("unable to write the certificate: %v", err)
+	}
+	return path, cert
+}
+
+func TestAccProviderClientCertificateAuthentication(t *testing.T) {
+	path, cert := writeTestClientCertificate(t)
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("POST", "https://login.mocked.local/22222222-2222-2222-2222-222222222222/oauth2/v2.0/token",
+		func(req *http.Request) (*http.Response, error) {
+			if err := req.ParseForm(); err != nil {
+				return nil, err
+			}
+			//the assertion must be signed with the key of the certificate
+			parts := strings.Split(req.PostForm.Get("client_assertion"), ".")
+			if req.PostForm.Get("client_id") != "33333333-3333-3333-3333-333333333333" ||
+				req.PostForm.Get("client_assertion_type") != "urn:ietf:params:oauth:client-assertion-type:jwt-bearer" ||
+				req.PostForm.Get("scope") != "api://44444444-4444-4444-4444-444444444444/.default" ||
+				len(parts) != 3 {
+				return httpmock.NewStringResponse(http.StatusUnauthorized, `{"error":"invalid_client","error_description":"unexpected token request"}`), nil
+			}
+			signature, _ := base64.RawURLEncoding.DecodeString(parts[2])
+			hashed := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+			if err := rsa.VerifyPKCS1v15(cert.PublicKey.(*rsa.PublicKey), crypto.SHA256, hashed[:], signature); err != nil {
+				return httpmock.NewStringResponse(http.StatusUnauthorized, `{"error":"invalid_client","error_description":"invalid assertion signature"}`), nil
+			}
+			return httpmock.NewStringResponse(http.StatusOK, `{"token_type":"Bearer","expires_in":3599,"access_token":"mockedClientCertificateToken"}`), nil
+		})
+	httpmock.RegisterResponder("GET", "https://mockedHost.azurewebsites.net/api/spaces/au?expand=false&utilization=false",
+		func(req *http.Request) (*http.Response, error) {
+			if req.Header.Get("Authorization") != "Bearer mockedClientCertificateToken" {
+				return httpmock.NewStringResponse(http.StatusUnauthorized, `{"error":"invalid token"}`), nil
+			}
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/datasource/spaces/space_without_utilization_and_vnet.json").String()), nil
+		})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: fmt.Sprintf(`provider "azureipam" {
+					api_url                 = "https://mockedHost.azurewebsites.net"
+					authority_host          = "https://login.mocked.local"
+					engine_app_id           = "44444444-4444-4444-4444-444444444444"
+					tenant_id               = "22222222-2222-2222-2222-222222222222"
+					client_id               = "33333333-3333-3333-3333-333333333333"
+					client_certificate_path = %q
+				}
+				data "azureipam_space" "test" {
+					name = "au"
+				}`, path),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.azureipam_space.test", "name", "au"),
+					resource.TestCheckResourceAttr("data.azureipam_space.test", "description", "Australia"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccProviderOidcTokenFileAuthentication(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "token")
+	if err := os.WriteFile(path, []byte("mockedFederatedToken\n"), 0600); err != nil {
+		t.Fatalf("unable to write the token file: %v", err)
+	}
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("POST", "https://login.mocked.local/22222222-2222-2222-2222-222222222222/oauth2/v2.0/token",
+		func(req *http.Request) (*http.Response, error) {
+			if err := req.ParseForm(); err != nil {
+				return nil, err
+			}
+			if req.PostForm.Get("client_id") != "33333333-3333-3333-3333-333333333333" ||
+				req.PostForm.Get("client_assertion_type") != "urn:ietf:params:oauth:client-assertion-type:jwt-bearer" ||
+				req.PostForm.Get("client_assertion") != "mockedFederatedToken" ||
+				req.PostForm.Get("scope") != "api://44444444-4444-4444-4444-444444444444/.default" {
+				return httpmock.NewStringResponse(http.StatusUnauthorized, `{"error":"invalid_client","error_description":"unexpected token request"}`), nil
+			}
+			return httpmock.NewStringResponse(http.StatusOK, `{"token_type":"Bearer","expires_in":3599,"access_token":"mockedOidcToken"}`), nil
+		})
+	httpmock.RegisterResponder("GET", "https://mockedHost.azurewebsites.net/api/spaces/au?expand=false&utilization=false",
+		func(req *http.Request) (*http.Response, error) {
+			if req.Header.Get("Authorization") != "Bearer mockedOidcToken" {
+				return httpmock.NewStringResponse(http.StatusUnauthorized, `{"error":"invalid token"}`), nil
+			}
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/datasource/spaces/space_without_utilization_and_vnet.json").String()), nil
+		})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: fmt.Sprintf(`provider "azureipam" {
+					api_url              = "https://mockedHost.azurewebsites.net"
+					authority_host       = "https://login.mocked.local"
+					engine_app_id        = "44444444-4444-4444-4444-444444444444"
+					tenant_id            = "22222222-2222-2222-2222-222222222222"
+					client_id            = "33333333-3333-3333-3333-333333333333"
+					oidc_token_file_path = %q
+				}
+				data "azureipam_space" "test" {
+					name = "au"
+				}`, path),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.azureipam_space.test", "name", "au"),
+					resource.TestCheckResourceAttr("data.azureipam_space.test", "description", "Australia"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccProviderTokenRenewedOnUnauthorized(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	requestedTokens := 0
+	httpmock.RegisterResponder("POST", "https://login.microsoftonline.com/22222222-2222-2222-2222-222222222222/oauth2/v2.0/token",
+		func(req *http.Request) (*http.Response, error) {
+			//the first token of each configured client has been revoked, the renewed one is valid
+			requestedTokens++
+			if requestedTokens%2 == 1 {
+				return httpmock.NewStringResponse(http.StatusOK, `{"token_type":"Bearer","expires_in":3599,"access_token":"mockedRevokedToken"}`), nil
+			}
+			return httpmock.NewStringResponse(http.StatusOK, `{"token_type":"Bearer","expires_in":3599,"access_token":"mockedRenewedToken"}`), nil
+		})
+	httpmock.RegisterResponder("GET", "https://mockedHost.azurewebsites.net/api/spaces/au?expand=false&utilization=false",
+		func(req *http.Request) (*http.Response, error) {
+			if req.Header.Get("Authorization") != "Bearer mockedRenewedToken" {
+				return httpmock.NewStringResponse(http.StatusUnauthorized, `{"error":"invalid token"}`), nil
+			}
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/datasource/spaces/space_without_utilization_and_vnet.json").String()), nil
+		})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: `provider "azureipam" {
+					api_url       = "https://mockedHost.azurewebsites.net"
+					engine_app_id = "44444444-4444-4444-4444-444444444444"
+					tenant_id     = "22222222-2222-2222-2222-222222222222"
+					client_id     = "33333333-3333-3333-3333-333333333333"
+					client_secret = "dummySecretForTesting"
+				}
+				data "azureipam_space" "test" {
+					name = "au"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.azureipam_space.test", "name", "au"),
+					resource.TestCheckResourceAttr("data.azureipam_space.test", "description", "Australia"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccProviderRetryTransientErrors(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	requests := 0
+	httpmock.RegisterResponder("GET", "https://mockedHost.azurewebsites.net/api/spaces/au?expand=false&utilization=false",
+		func(req *http.Request) (*http.Response, error) {
+			//the two first attempts of each read are throttled
+			requests++
+			if requests%3 != 0 {
+				response := httpmock.NewStringResponse(http.StatusServiceUnavailable, `{"error":"service unavailable"}`)
+				response.Header.Set("Retry-After", "0")
+				return response, nil
+			}
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/datasource/spaces/space_without_utilization_and_vnet.json").String()), nil
+		})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: `provider "azureipam" {
+					api_url        = "https://mockedHost.azurewebsites.net"
+					token          = "dummyForTesting"
+					max_retries    = 2
+					retry_max_wait = 1
+				}
+				data "azureipam_space" "test" {
+					name = "au"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.azureipam_space.test", "name", "au"),
+					resource.TestCheckResourceAttr("data.azureipam_space.test", "description", "Australia"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccProviderRetryNotAppliedToNonIdempotentRequests(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("POST", "https://mockedHost.azurewebsites.net/api/spaces",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusServiceUnavailable, `{"error":"service unavailable"}`), nil
+		})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create testing
+			{
+				Config: `provider "azureipam" {
+					api_url        = "https://mockedHost.azurewebsites.net"
+					token          = "dummyForTesting"
+					max_retries    = 2
+					retry_min_wait = 0
+				}
+				resource "azureipam_space" "test" {
+					name        = "acctest"
+					description = "Space for Acceptance Tests"
+				}`,
+				ExpectError: regexp.MustCompile("status: 503"),
+			},
+		},
+	})
+
+	if calls := httpmock.GetCallCountInfo()["POST https://mockedHost.azurewebsites.net/api/spaces"]; calls != 1 {
+		t.Errorf("expected 1 POST request, got %d", calls)
+	}
+}
+
+func TestAccProviderRequestTimeout(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("POST", "https://mockedHost.azurewebsites.net/api/spaces",
+		func(req *http.Request) (*http.Response, error) {
+			//never answers, waiting until the request is cancelled
+			<-req.Context().Done()
+			return nil, req.Context().Err()
+		})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create testing
+			{
+				Config: `provider "azureipam" {
+					api_url         = "https://mockedHost.azurewebsites.net"
+					token           = "dummyForTesting"
+					request_timeout = 1
+				}
+				resource "azureipam_space" "test" {
+					name        = "acctest"
+					description = "Space for Acceptance Tests"
+				}`,
+				ExpectError: regexp.MustCompile("Client.Timeout exceeded"),
+			},
+		},
+	})
+}
+
+func TestAccProviderEngineStatusNotRequired(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("GET", "https://mockedHost.azurewebsites.net/api/status",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusServiceUnavailable, `{"error":"service unavailable"}`), nil
+		})
+	httpmock.RegisterResponder("GET", "https://mockedHost.azurewebsites.net/api/spaces/au?expand=false&utilization=false",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/datasource/spaces/space_without_utilization_and_vnet.json").String()), nil
+		})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing, the engine version can't be checked but the configuration continues with a warning
+			{
+				Config: testAccProviderConfig + `data "azureipam_space" "test" {
+					name = "au"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.azureipam_space.test", "name", "au"),
+				),
+			},
+		},
+	})
+
+	if calls := httpmock.GetCallCountInfo()["GET https://mockedHost.azurewebsites.net/api/status"]; calls == 0 {
+		t.Errorf("expected the engine status to be requested")
+	}
+}
+
+func TestAccProviderEngineWithoutStatus(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("GET", "https://mockedHost.azurewebsites.net/api/status",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusNotFound, `{"detail":"Not Found"}`), nil
+		})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Configure testing, the status is not found with a wrong url or an engine older than the supported version
+			{
+				Config: testAccProviderConfig + `data "azureipam_space" "test" {
+					name = "au"
+				}`,
+				ExpectError: regexp.MustCompile("AzureIpam Engine Status Not Found"),
+			},
+		},
+	})
+}
+
+func TestAccProviderUnknownCredential(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// The client secret is only known after apply, so the provider can't be configured during the plan
+			{
+				Config: `resource "terraform_data" "secret" {
+					input = "dummySecretForTesting"
+				}
+				provider "azureipam" {
+					api_url       = "https://mockedHost.azurewebsites.net"
+					engine_app_id = "44444444-4444-4444-4444-444444444444"
+					tenant_id     = "22222222-2222-2222-2222-222222222222"
+					client_id     = "33333333-3333-3333-3333-333333333333"
+					client_secret = terraform_data.secret.output
+				}
+				data "azureipam_space" "test" {
+					name = "au"
+				}`,
+				ExpectError: regexp.MustCompile("Unknown AzureIpam authentication configuration"),
+			},
+		},
+	})
+}
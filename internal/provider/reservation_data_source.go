@@ -111,7 +111,7 @@ func (d *reservationDataSource) Read(ctx context.Context, req datasource.ReadReq
 	// Read Terraform configuration state into the model
 	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
 
-	reservation, err := d.client.GetReservation(
+	reservation, err := d.client.GetReservation(ctx,
 		state.Space.ValueString(),
 		state.Block.ValueString(),
 		state.Id.ValueString())
@@ -13,9 +13,9 @@ import (
 func TestAccReservationDataSource(t *testing.T) {
 	httpmock.Activate()
 	defer httpmock.DeactivateAndReset()
-	httpmock.RegisterResponder("GET", "https://mockedHost.azurewebsites.net/api/spaces/au/blocks/AustraliaEast/reservations?settled=true",
+	httpmock.RegisterResponder("GET", "https://mockedHost.azurewebsites.net/api/spaces/au/blocks/AustraliaEast/reservations/3MFHm4s88SVrH8nQ4cK9Um",
 		func(req *http.Request) (*http.Response, error) {
-			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/datasource/reservations/reservations_with_settled.json").String()), nil
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/datasource/reservations/reservation_wait.json").String()), nil
 		})
 
 	resource.Test(t, resource.TestCase{
@@ -3,6 +3,8 @@ package provider
 import (
 	"context"
 	"fmt"
+	"net/netip"
+	"regexp"
 	"strconv"
 	"strings"
 	"time"
@@ -10,22 +12,33 @@ import (
 	ipamclient "terraform-provider-azureipam/ipamclient"
 
 	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &reservationResource{}
-	_ resource.ResourceWithConfigure   = &reservationResource{}
-	_ resource.ResourceWithImportState = &reservationResource{}
+	_ resource.Resource                     = &reservationResource{}
+	_ resource.ResourceWithConfigure        = &reservationResource{}
+	_ resource.ResourceWithImportState      = &reservationResource{}
+	_ resource.ResourceWithConfigValidators = &reservationResource{}
+	_ resource.ResourceWithValidateConfig   = &reservationResource{}
+	_ resource.ResourceWithMoveState        = &reservationResource{}
+	_ resource.ResourceWithModifyPlan       = &reservationResource{}
 )
 
 // NewReservationResource is a helper function to simplify the provider implementation.
@@ -39,17 +52,20 @@ type reservationResourceModel struct {
 	Blocks        types.List        `tfsdk:"blocks"`
 	Size          types.Int32       `tfsdk:"size"`
 	Description   types.String      `tfsdk:"description"`
+	UserTags      types.Map         `tfsdk:"user_tags"`
 	ReverseSearch types.Bool        `tfsdk:"reverse_search"`
 	SmallestCidr  types.Bool        `tfsdk:"smallest_cidr"`
 	Id            types.String      `tfsdk:"id"`
 	Block         types.String      `tfsdk:"block"`
 	Cidr          types.String      `tfsdk:"cidr"`
+	PredictedCidr types.String      `tfsdk:"predicted_cidr"`
 	CreatedBy     types.String      `tfsdk:"created_by"`
 	CreatedOn     timetypes.RFC3339 `tfsdk:"created_on"`
 	SettledBy     types.String      `tfsdk:"settled_by"`
 	SettledOn     timetypes.RFC3339 `tfsdk:"settled_on"`
 	Status        types.String      `tfsdk:"status"`
 	Tags          types.Map         `tfsdk:"tags"`
+	Timeouts      timeouts.Value    `tfsdk:"timeouts"`
 }
 
 // reservationResource is the resource implementation.
@@ -63,9 +79,9 @@ func (r *reservationResource) Metadata(_ context.Context, req resource.MetadataR
 }
 
 // Schema defines the schema for the resource.
-func (r *reservationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *reservationResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "The reservation resource allows you to create a IPAM reservation in the specific space and list of blocks.",
+		Description: "The reservation resource allows you to create a IPAM reservation in the specific space and list of blocks, with the specified size or a fixed cidr.",
 		Attributes: map[string]schema.Attribute{
 			"space": schema.StringAttribute{
 				Description: "Name of the existing space in the IPAM application. Changing this forces a new resource to be created.",
@@ -83,16 +99,27 @@ func (r *reservationResource) Schema(_ context.Context, _ resource.SchemaRequest
 				},
 			},
 			"size": schema.Int32Attribute{
-				Description: "Integer value to indicate the subnet mask bits, which defines the size of the vnet to reserve (example 24 for a /24 subnet). Changing this forces a new resource to be created.",
-				Required:    true,
+				Description: "Integer value to indicate the subnet mask bits, which defines the size of the vnet to reserve (example 24 for a /24 subnet). Exactly one of `size` or `cidr` must be specified, between 8 and 29, and not larger than the smallest candidate block. Changing this forces a new resource to be created.",
+				Optional:    true,
+				Validators: []validator.Int32{
+					int32validator.Between(minPrefixLength, maxVnetPrefixLength),
+				},
 				PlanModifiers: []planmodifier.Int32{
-					int32planmodifier.RequiresReplace(),
+					int32planmodifier.RequiresReplaceIfConfigured(),
 				},
 			},
 			"description": schema.StringAttribute{
 				Description: "Description text that describe the reservation, that will be added as an additional tag.",
 				Optional:    true,
 			},
+			"user_tags": schema.MapAttribute{
+				Description: "Custom tags for the reservation, sent to the IPAM application and merged with the auto-generated tags in the `tags` attribute. Tags with the 'X-IPAM-' prefix are reserved to IPAM, and the auto-generated ones prevail. Changing this forces a new resource to be created.",
+				Optional:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
 			"reverse_search": schema.BoolAttribute{
 				Description: "New networks will be created as close to the end of the block as possible?. Defaults to `false`. Changing this forces a new resource to be created.",
 				Optional:    true,
@@ -116,7 +143,18 @@ func (r *reservationResource) Schema(_ context.Context, _ resource.SchemaRequest
 				Computed:    true,
 			},
 			"cidr": schema.StringAttribute{
-				Description: "The assigned and reserved range, in cidr notation.",
+				Description: "The specific range to reserve, in cidr notation, or the assigned and reserved range when `size` is specified. Exactly one of `size` or `cidr` must be specified, and `cidr` is only allowed with a single block. Only IPv4 ranges with a prefix length between 8 and 29 are allowed, IPv6 ranges are rejected since they are not supported by the IPAM engine. Changing this forces a new resource to be created.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					ipv4CidrValidator(minPrefixLength, maxVnetPrefixLength),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+			},
+			"predicted_cidr": schema.StringAttribute{
+				Description: "Best-effort prediction of the range that will be assigned to a reservation by size, calculated during the plan with the next available range of the blocks. It is only a preview, the assigned range in the `cidr` attribute can differ if other reservations are created before the apply.",
 				Computed:    true,
 			},
 			"created_by": schema.StringAttribute{
@@ -142,11 +180,18 @@ func (r *reservationResource) Schema(_ context.Context, _ resource.SchemaRequest
 				Computed:    true,
 			},
 			"tags": schema.MapAttribute{
-				Description: "Auto-generated tags for the reservation. Particular relevance the 'X-IPAM-RES-ID' tag, since it must be included in the vnet creation in order that the IPAM solution automatically considers the reservation as completed.",
+				Description: "Tags of the reservation as returned by the IPAM application, the auto-generated ones and the `user_tags`. Particular relevance the 'X-IPAM-RES-ID' tag, since it must be included in the vnet creation in order that the IPAM solution automatically considers the reservation as completed.",
 				Computed:    true,
 				ElementType: types.StringType,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -160,15 +205,34 @@ func (r *reservationResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	var blocks *[]string
 	diag:= plan.Blocks.ElementsAs(ctx, &blocks, false)
 	resp.Diagnostics.Append(diag...)
- 	reservation, err := r.client.CreateReservation(
+	//the cidr is only known when it has been configured, otherwise it will be assigned by size
+	var specificCidr *string
+	if !plan.Cidr.IsUnknown() {
+		specificCidr = plan.Cidr.ValueStringPointer()
+	}
+	var userTags map[string]string
+	resp.Diagnostics.Append(plan.UserTags.ElementsAs(ctx, &userTags, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+ 	reservation, err := r.client.CreateReservation(ctx,
 		plan.Space.ValueString(),
 		*blocks,
 		plan.Description.ValueStringPointer(),
+		userTags,
 		plan.Size.ValueInt32Pointer(),
-		nil,
+		specificCidr,
 		plan.ReverseSearch.ValueBool(),
 		plan.SmallestCidr.ValueBool(),
 	)
@@ -181,8 +245,21 @@ func (r *reservationResource) Create(ctx context.Context, req resource.CreateReq
 	}
 
 	// Map response body to schema and populate Computed attribute values
+	if !plan.PredictedCidr.IsNull() && !plan.PredictedCidr.IsUnknown() && plan.PredictedCidr.ValueString() != reservation.Cidr {
+		resp.Diagnostics.AddWarning(
+			"Reservation range differs from the prediction",
+			fmt.Sprintf("The reservation with id %s has been assigned the %s range, instead of the %s range predicted during the plan. Other reservations may have been created after the plan.", reservation.Id, reservation.Cidr, plan.PredictedCidr.ValueString()),
+		)
+	}
+	if plan.PredictedCidr.IsUnknown() {
+		plan.PredictedCidr = types.StringNull()
+	}
 	flattenReservation(reservation, &plan)
-	plan.Tags, _ = types.MapValueFrom(ctx, types.StringType, reservation.Tags)
+	plan.Tags, _, diags = flattenReservationTags(ctx, reservation.Tags, plan.UserTags)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, plan)
@@ -202,10 +279,21 @@ func (r *reservationResource) Read(ctx context.Context, req resource.ReadRequest
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	//read reservation
-	reservation, err := r.client.FindReservationById(
-		state.Id.ValueString(),
-	)
+	reservation, err := readReservation(ctx, r.client, state.Space, state.Block, state.Id)
+	if ipamclient.IsNotFound(err) {
+		//deleted outside terraform, remove from state to plan its recreation
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading AzureIpam Reservation",
@@ -215,20 +303,27 @@ func (r *reservationResource) Read(ctx context.Context, req resource.ReadRequest
 	}
 
 	// Overwrite items with refreshed state
+	imported := state.Cidr.IsNull()
 	flattenReservation(reservation, &state)
 	// state.ReverseSearch = types.BoolValue(reverse_search)
 	// state.SmallestCidr = types.BoolValue(smallest_cidr)
-	state.Tags, _ = types.MapValueFrom(ctx, types.StringType, reservation.Tags)
-	//Calculate requested size from assigned Cidr
-	size, err := strconv.Atoi(strings.Split(reservation.Cidr, "/")[1])
-	state.Size = types.Int32Value(int32(size))
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Reading AzureIpam Reservation",
-			"Could not determinate requested size for Reservation with id "+state.Id.ValueString()+": "+err.Error(),
-		)
+	state.Tags, state.UserTags, diags = flattenReservationTags(ctx, reservation.Tags, state.UserTags)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
+	//Calculate requested size from assigned Cidr, unless it was reserved by cidr
+	if imported || !state.Size.IsNull() {
+		size, err := strconv.Atoi(strings.Split(reservation.Cidr, "/")[1])
+		state.Size = types.Int32Value(int32(size))
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading AzureIpam Reservation",
+				"Could not determinate requested size for Reservation with id "+state.Id.ValueString()+": "+err.Error(),
+			)
+			return
+		}
+	}
 
 	// Set refreshed state
 	diags = resp.State.Set(ctx, &state)
@@ -238,16 +333,26 @@ func (r *reservationResource) Read(ctx context.Context, req resource.ReadRequest
 	}
 }
 
-// Update not allowed, returning readed plan as current state.
+// Update not allowed, only the attributes that don't force a new resource are copied from the plan to the
+// current state, keeping the computed attributes that are unknown in the plan.
 func (n *reservationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var model reservationResource
+	var state reservationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	var plan reservationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+	state.Size = plan.Size
+	state.Description = plan.Description
+	state.Timeouts = plan.Timeouts
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
 func (r *reservationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -259,8 +364,16 @@ func (r *reservationResource) Delete(ctx context.Context, req resource.DeleteReq
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	// Delete existing reservation
-	err := r.client.DeleteReservation(
+	err := r.client.DeleteReservation(ctx,
 		state.Space.ValueString(),
 		state.Block.ValueString(),
 		state.Id.ValueString(),
@@ -296,8 +409,296 @@ func (r *reservationResource) Configure(_ context.Context, req resource.Configur
 }
 
 func (r *reservationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Retrieve import ID and save to id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importReservationState(ctx, req, resp)
+}
+
+// ConfigValidators returns the validators of the attributes that depend on each other.
+func (r *reservationResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("size"),
+			path.MatchRoot("cidr"),
+		),
+		resourcevalidator.Conflicting(
+			path.MatchRoot("cidr"),
+			path.MatchRoot("reverse_search"),
+		),
+		resourcevalidator.Conflicting(
+			path.MatchRoot("cidr"),
+			path.MatchRoot("smallest_cidr"),
+		),
+	}
+}
+
+// ValidateConfig ensures that a reservation by cidr is requested in only one block.
+func (r *reservationResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config reservationResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Cidr.IsNull() || config.Blocks.IsUnknown() || len(config.Blocks.Elements()) <= 1 {
+		return
+	}
+	resp.Diagnostics.AddAttributeError(
+		path.Root("cidr"),
+		"Invalid Attribute Combination",
+		fmt.Sprintf("The cidr attribute is only allowed when a single block is specified, got %d blocks.", len(config.Blocks.Elements())),
+	)
+}
+
+// ModifyPlan checks that the requested size fits in the candidate blocks, and predicts the range that will be
+// assigned, when a new reservation by size is planned.
+func (r *reservationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	//destroy plan
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan reservationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var state *reservationResourceModel
+	if !req.State.Raw.IsNull() {
+		state = &reservationResourceModel{}
+		resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if !reservationRequiresReplace(plan, *state) {
+			//updated in place, the reservation keeps its range
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("predicted_cidr"), state.PredictedCidr)...)
+			return
+		}
+	}
+	//the range is known when it is reserved by cidr
+	if !plan.Cidr.IsUnknown() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("predicted_cidr"), types.StringNull())...)
+		return
+	}
+
+	//not configured client or unknown values
+	if r.client == nil || plan.Size.IsNull() || plan.Size.IsUnknown() || plan.Space.IsUnknown() || plan.Blocks.IsUnknown() ||
+		plan.ReverseSearch.IsUnknown() || plan.SmallestCidr.IsUnknown() {
+		return
+	}
+	var blocks []string
+	for _, block := range plan.Blocks.Elements() {
+		value, ok := block.(types.String)
+		if !ok || value.IsUnknown() {
+			return
+		}
+		blocks = append(blocks, value.ValueString())
+	}
+
+	//the size of an existing reservation has already been checked
+	if state == nil || !state.Size.Equal(plan.Size) {
+		blocksInfo, err := r.client.GetBlocks(ctx, plan.Space.ValueString(), false, false)
+		if err != nil {
+			//the IPAM application validates the reservation anyway, so the check is skipped
+			tflog.Debug(ctx, "Could not read the blocks to check the reservation size", map[string]any{"space": plan.Space.ValueString(), "error": err.Error()})
+		} else {
+			resp.Diagnostics.Append(checkReservationSize(plan.Size.ValueInt32(), blocks, *blocksInfo)...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+	}
+
+	nextAvailable, err := r.client.GetNextAvailableVnet(ctx,
+		plan.Space.ValueString(),
+		blocks,
+		plan.Size.ValueInt32(),
+		plan.ReverseSearch.ValueBool(),
+		plan.SmallestCidr.ValueBool(),
+	)
+	if err != nil {
+		//the prediction is only a preview, the range stays unknown until the apply
+		tflog.Debug(ctx, "Could not predict the reservation range", map[string]any{"space": plan.Space.ValueString(), "error": err.Error()})
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("predicted_cidr"), types.StringValue(nextAvailable.Cidr))...)
+}
+
+// reservationRequiresReplace returns if the planned changes recreate the reservation, as the plan modifiers
+// of the schema do.
+func reservationRequiresReplace(plan reservationResourceModel, state reservationResourceModel) bool {
+	return !plan.Space.Equal(state.Space) ||
+		!plan.Blocks.Equal(state.Blocks) ||
+		(!plan.Size.IsNull() && !plan.Size.Equal(state.Size)) ||
+		(!plan.Cidr.IsNull() && !plan.Cidr.IsUnknown() && !plan.Cidr.Equal(state.Cidr)) ||
+		!plan.UserTags.Equal(state.UserTags) ||
+		!plan.ReverseSearch.Equal(state.ReverseSearch) ||
+		!plan.SmallestCidr.Equal(state.SmallestCidr)
+}
+
+// MoveState allows to move the azureipam_reservation_cidr resources to this resource with moved blocks.
+func (r *reservationResource) MoveState(ctx context.Context) []resource.StateMover {
+	var sourceSchema resource.SchemaResponse
+	NewReservationCidrResource().Schema(ctx, resource.SchemaRequest{}, &sourceSchema)
+
+	return []resource.StateMover{
+		{
+			SourceSchema: &sourceSchema.Schema,
+			StateMover:   moveReservationCidrState,
+		},
+	}
+}
+
+// moveReservationCidrState maps the state of an azureipam_reservation_cidr resource, the assigned cidr is kept as the requested one.
+func moveReservationCidrState(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+	if req.SourceTypeName != "azureipam_reservation_cidr" || req.SourceState == nil {
+		return
+	}
+
+	var source reservationResourceCidrModel
+	resp.Diagnostics.Append(req.SourceState.Get(ctx, &source)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	blocks, diags := types.ListValueFrom(ctx, types.StringType, []string{source.Block.ValueString()})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	target := reservationResourceModel{
+		Space:         source.Space,
+		Blocks:        blocks,
+		Size:          types.Int32Null(),
+		Description:   source.Description,
+		UserTags:      source.UserTags,
+		ReverseSearch: types.BoolNull(),
+		SmallestCidr:  types.BoolNull(),
+		Id:            source.Id,
+		Block:         source.Block,
+		Cidr:          source.Cidr,
+		PredictedCidr: types.StringNull(),
+		CreatedBy:     source.CreatedBy,
+		CreatedOn:     source.CreatedOn,
+		SettledBy:     source.SettledBy,
+		SettledOn:     source.SettledOn,
+		Status:        source.Status,
+		Tags:          source.Tags,
+		Timeouts:      source.Timeouts,
+	}
+
+	resp.Diagnostics.Append(resp.TargetState.Set(ctx, target)...)
+}
+
+// checkReservationSize reports the size larger than the smallest candidate block, since the reservation must fit
+// in any of the candidates. The blocks that don't exist are reported by the IPAM application.
+func checkReservationSize(size int32, candidates []string, blocksInfo []ipamclient.BlockInfo) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var smallest *ipamclient.BlockInfo
+	smallestBits := 0
+	for _, candidate := range candidates {
+		for i, blockInfo := range blocksInfo {
+			if blockInfo.Name != candidate {
+				continue
+			}
+			prefix, err := netip.ParsePrefix(blockInfo.Cidr)
+			if err != nil {
+				diags.AddAttributeWarning(
+					path.Root("blocks"),
+					"Unable to Check the Reservation Size",
+					fmt.Sprintf("The IPAM engine returned the invalid cidr %q for the block %s, so the reservation size can't be checked against it.", blockInfo.Cidr, blockInfo.Name),
+				)
+				continue
+			}
+			if smallest == nil || prefix.Bits() > smallestBits {
+				smallest = &blocksInfo[i]
+				smallestBits = prefix.Bits()
+			}
+		}
+	}
+
+	if smallest != nil && int(size) < smallestBits {
+		diags.AddAttributeError(
+			path.Root("size"),
+			"Invalid Reservation Size",
+			fmt.Sprintf("A /%d reservation is larger than the smallest candidate block %s (%s), the size must be /%d or smaller.", size, smallest.Name, smallest.Cidr, smallestBits),
+		)
+	}
+
+	return diags
+}
+
+// readReservation reads the reservation from its space and block, searching it by id in all the spaces and blocks
+// only when they are unknown, as happens after importing it only by id. The engine doesn't delete the reservations,
+// so the cancelled or failed ones are reported as not found.
+func readReservation(ctx context.Context, client *ipamclient.Client, space types.String, block types.String, id types.String) (*ipamclient.Reservation, error) {
+	var reservation *ipamclient.Reservation
+	var err error
+	if space.ValueString() == "" || block.ValueString() == "" {
+		reservation, err = client.FindReservationById(ctx, id.ValueString())
+	} else {
+		reservation, err = client.GetReservation(ctx, space.ValueString(), block.ValueString(), id.ValueString())
+	}
+	if err != nil {
+		return nil, err
+	}
+	if ipamclient.IsReservationClosed(reservation) {
+		return nil, fmt.Errorf("reservation %s is %s, %w", reservation.Id, reservation.Status, ipamclient.ErrNotFound)
+	}
+	return reservation, nil
+}
+
+// importReservationState accepts the {SpaceName}/{BlockName}/{ReservationId} import ID, and also the reservation id alone.
+func importReservationState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if !strings.Contains(req.ID, "/") {
+		// Retrieve import ID and save to id attribute
+		resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+		return
+	}
+
+	// Retrieve import ID, validate, split and save to space, block and id attributes
+	re := regexp.MustCompile("^(?<space>[a-zA-Z0-9]+)/(?<block>[a-zA-Z0-9]+)/(?<id>[a-zA-Z0-9]+)$")
+	//validate
+	if !re.MatchString(req.ID) {
+		resp.Diagnostics.AddError(
+			"Error Importing AzureIpam Reservation",
+			"The specified ID is not in the correct format {SpaceName}/{BlockName}/{ReservationId} or {ReservationId}.",
+		)
+		return
+	}
+	//extract values
+	matches := re.FindStringSubmatch(req.ID)
+	space := matches[re.SubexpIndex("space")]
+	block := matches[re.SubexpIndex("block")]
+	id := matches[re.SubexpIndex("id")]
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("space"), space)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("block"), block)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+// flattenReservationTags returns the tags of the reservation, and the user tags that are the ones not generated by
+// IPAM, as returned by the engine so the tags changed outside terraform are detected. The user tags are null when
+// there is none, unless they are currently an empty map.
+func flattenReservationTags(ctx context.Context, reservationTags map[string]string, current types.Map) (types.Map, types.Map, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	tags, tagsDiags := types.MapValueFrom(ctx, types.StringType, reservationTags)
+	diags.Append(tagsDiags...)
+
+	userTags := map[string]string{}
+	for key, value := range reservationTags {
+		if !strings.HasPrefix(strings.ToUpper(key), "X-IPAM-") {
+			userTags[key] = value
+		}
+	}
+	if len(userTags) == 0 && current.IsNull() {
+		return tags, types.MapNull(types.StringType), diags
+	}
+	userTagsValue, userTagsDiags := types.MapValueFrom(ctx, types.StringType, userTags)
+	diags.Append(userTagsDiags...)
+
+	return tags, userTagsValue, diags
 }
 
 func flattenReservation(reservation *ipamclient.Reservation, model *reservationResourceModel) {
@@ -7,12 +7,14 @@ import (
 
 	ipamclient "terraform-provider-azureipam/ipamclient"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -34,6 +36,7 @@ type reservationResourceCidrModel struct {
 	Block        types.String      `tfsdk:"block"`
 	SpecificCidr types.String      `tfsdk:"specific_cidr"`
 	Description  types.String      `tfsdk:"description"`
+	UserTags     types.Map         `tfsdk:"user_tags"`
 	Id           types.String      `tfsdk:"id"`
 	Cidr         types.String      `tfsdk:"cidr"`
 	CreatedBy    types.String      `tfsdk:"created_by"`
@@ -42,6 +45,7 @@ type reservationResourceCidrModel struct {
 	SettledOn    timetypes.RFC3339 `tfsdk:"settled_on"`
 	Status       types.String      `tfsdk:"status"`
 	Tags         types.Map         `tfsdk:"tags"`
+	Timeouts     timeouts.Value    `tfsdk:"timeouts"`
 }
 
 // reservationResourceCidr is the resource implementation.
@@ -55,9 +59,11 @@ func (r *reservationResourceCidr) Metadata(_ context.Context, req resource.Metad
 }
 
 // Schema defines the schema for the resource.
-func (r *reservationResourceCidr) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *reservationResourceCidr) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "The reservation resource allows you to create a IPAM reservation in the specific space and block with a fixed cidr.",
+		DeprecationMessage: "The azureipam_reservation_cidr resource is deprecated, use the cidr attribute of the azureipam_reservation resource instead. " +
+			"The existing resources can be migrated with a moved block, that requires Terraform 1.8 or later.",
 		Attributes: map[string]schema.Attribute{
 			"space": schema.StringAttribute{
 				Description: "Name of the existing space in the IPAM application. Changing this forces a new resource to be created.",
@@ -74,8 +80,11 @@ func (r *reservationResourceCidr) Schema(_ context.Context, _ resource.SchemaReq
 				},
 			},
 			"specific_cidr": schema.StringAttribute{
-				Description: "The specific CIDR to reserve, in cidr notation. At least one of size or specific_cidr attribute must be specified. Not allowed if more than one block is specified.",
+				Description: "The specific CIDR to reserve, in cidr notation. At least one of size or specific_cidr attribute must be specified. Not allowed if more than one block is specified. Only IPv4 ranges with a prefix length between 8 and 29 are allowed, IPv6 ranges are rejected since they are not supported by the IPAM engine.",
 				Optional:    true,
+				Validators: []validator.String{
+					ipv4CidrValidator(minPrefixLength, maxVnetPrefixLength),
+				},
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
@@ -84,6 +93,14 @@ func (r *reservationResourceCidr) Schema(_ context.Context, _ resource.SchemaReq
 				Description: "Description text that describe the reservation, that will be added as an additional tag.",
 				Optional:    true,
 			},
+			"user_tags": schema.MapAttribute{
+				Description: "Custom tags for the reservation, sent to the IPAM application and merged with the auto-generated tags in the `tags` attribute. Tags with the 'X-IPAM-' prefix are reserved to IPAM, and the auto-generated ones prevail. Changing this forces a new resource to be created.",
+				Optional:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
 			"id": schema.StringAttribute{
 				Description: "The unique identifier of the generated reservation.",
 				Computed:    true,
@@ -115,11 +132,18 @@ func (r *reservationResourceCidr) Schema(_ context.Context, _ resource.SchemaReq
 				Computed:    true,
 			},
 			"tags": schema.MapAttribute{
-				Description: "Auto-generated tags for the reservation. Particular relevance the 'X-IPAM-RES-ID' tag, since it must be included in the vnet creation in order that the IPAM solution automatically considers the reservation as completed.",
+				Description: "Tags of the reservation as returned by the IPAM application, the auto-generated ones and the `user_tags`. Particular relevance the 'X-IPAM-RES-ID' tag, since it must be included in the vnet creation in order that the IPAM solution automatically considers the reservation as completed.",
 				Computed:    true,
 				ElementType: types.StringType,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -133,11 +157,25 @@ func (r *reservationResourceCidr) Create(ctx context.Context, req resource.Creat
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	var userTags map[string]string
+	resp.Diagnostics.Append(plan.UserTags.ElementsAs(ctx, &userTags, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	block := []string{plan.Block.ValueString()}
-	reservation, err := r.client.CreateReservation(
+	reservation, err := r.client.CreateReservation(ctx,
 		plan.Space.ValueString(),
 		block,
 		plan.Description.ValueStringPointer(),
+		userTags,
 		nil,
 		plan.SpecificCidr.ValueStringPointer(),
 		false,
@@ -153,7 +191,11 @@ func (r *reservationResourceCidr) Create(ctx context.Context, req resource.Creat
 
 	// Map response body to schema and populate Computed attribute values
 	flattenReservationCidr(reservation, &plan)
-	plan.Tags, _ = types.MapValueFrom(ctx, types.StringType, reservation.Tags)
+	plan.Tags, _, diags = flattenReservationTags(ctx, reservation.Tags, plan.UserTags)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	// //Calculate requested size from assigned Cidr
 	// size, err := strconv.Atoi(strings.Split(reservation.Cidr, "/")[1])
 	// plan.Size = types.Int32Value(int32(size))
@@ -183,10 +225,21 @@ func (r *reservationResourceCidr) Read(ctx context.Context, req resource.ReadReq
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	//read reservation
-	reservation, err := r.client.FindReservationById(
-		state.Id.ValueString(),
-	)
+	reservation, err := readReservation(ctx, r.client, state.Space, state.Block, state.Id)
+	if ipamclient.IsNotFound(err) {
+		//deleted outside terraform, remove from state to plan its recreation
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading AzureIpam Reservation",
@@ -197,7 +250,11 @@ func (r *reservationResourceCidr) Read(ctx context.Context, req resource.ReadReq
 
 	// Overwrite items with refreshed state
 	flattenReservationCidr(reservation, &state)
-	state.Tags, _ = types.MapValueFrom(ctx, types.StringType, reservation.Tags)
+	state.Tags, state.UserTags, diags = flattenReservationTags(ctx, reservation.Tags, state.UserTags)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	// Set refreshed state
 	diags = resp.State.Set(ctx, &state)
@@ -207,16 +264,25 @@ func (r *reservationResourceCidr) Read(ctx context.Context, req resource.ReadReq
 	}
 }
 
-// Update not allowed, returning readed plan as current state.
+// Update not allowed, only the attributes that don't force a new resource are copied from the plan to the
+// current state, keeping the computed attributes that are unknown in the plan.
 func (n *reservationResourceCidr) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var model reservationResourceCidr
+	var state reservationResourceCidrModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	var plan reservationResourceCidrModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+	state.Description = plan.Description
+	state.Timeouts = plan.Timeouts
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
 func (r *reservationResourceCidr) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -228,8 +294,16 @@ func (r *reservationResourceCidr) Delete(ctx context.Context, req resource.Delet
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	// Delete existing reservation
-	err := r.client.DeleteReservation(
+	err := r.client.DeleteReservation(ctx,
 		state.Space.ValueString(),
 		state.Block.ValueString(),
 		state.Id.ValueString(),
@@ -265,8 +339,7 @@ func (r *reservationResourceCidr) Configure(_ context.Context, req resource.Conf
 }
 
 func (r *reservationResourceCidr) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Retrieve import ID and save to id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importReservationState(ctx, req, resp)
 }
 
 func flattenReservationCidr(reservation *ipamclient.Reservation, model *reservationResourceCidrModel) {
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/jarcoal/httpmock"
 )
 
@@ -25,9 +26,9 @@ func TestAccReservationCidrResource(t *testing.T) {
 		func(req *http.Request) (*http.Response, error) {
 			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/resource/reservation_cidr/spaces_with_new_reservation_info.json").String()), nil
 		})
-	httpmock.RegisterResponder("GET", "https://mockedHost.azurewebsites.net/api/spaces/au/blocks/AustraliaSoutheast/reservations?settled=true",
+	httpmock.RegisterResponder("GET", "https://mockedHost.azurewebsites.net/api/spaces/au/blocks/AustraliaSoutheast/reservations/Etc4svKttPXMQyvCb9sjy2",
 		func(req *http.Request) (*http.Response, error) {
-			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/resource/reservation_cidr/reservations_with_new_reservation.json").String()), nil
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/resource/reservation_cidr/new_reservation.json").String()), nil
 		})
 
 	resource.Test(t, resource.TestCase{
@@ -71,7 +72,38 @@ func TestAccReservationCidrResource(t *testing.T) {
 				ImportStateVerify:       true,
 				ImportStateVerifyIgnore: []string{},
 			},
-			// Update  NOT ALLOWED by provider
+			// ImportState testing with space and block, avoiding to search in all spaces
+			{
+				ResourceName:            "azureipam_reservation_cidr.test",
+				ImportState:             true,
+				ImportStateId:           "au/AustraliaSoutheast/Etc4svKttPXMQyvCb9sjy2",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{},
+			},
+			// Update NOT ALLOWED by provider, only the timeouts can be changed keeping the computed attributes
+			{
+				Config: testAccProviderConfig + `resource "azureipam_reservation_cidr" "test" {
+					space          = "au"
+					block          = "AustraliaSoutheast"
+					specific_cidr  = "10.82.4.0/24"
+					description    = "acceptance-test"
+					timeouts {
+						read = "10m"
+					}
+				}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("azureipam_reservation_cidr.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("azureipam_reservation_cidr.test", "id", "Etc4svKttPXMQyvCb9sjy2"),
+					resource.TestCheckResourceAttr("azureipam_reservation_cidr.test", "cidr", "10.82.4.0/24"),
+					resource.TestCheckResourceAttr("azureipam_reservation_cidr.test", "status", "wait"),
+					resource.TestCheckResourceAttr("azureipam_reservation_cidr.test", "tags.X-IPAM-RES-ID", "Etc4svKttPXMQyvCb9sjy2"),
+					resource.TestCheckResourceAttr("azureipam_reservation_cidr.test", "timeouts.read", "10m"),
+				),
+			},
 
 			// Delete testing automatically occurs in TestCase
 		},
@@ -3,16 +3,29 @@ package provider
 import (
 	"errors"
 	"net/http"
+	"regexp"
 	"testing"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	"github.com/jarcoal/httpmock"
 )
 
 func TestAccReservationResource(t *testing.T) {
 	httpmock.Activate()
 	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("GET", "https://mockedHost.azurewebsites.net/api/spaces/au/blocks?expand=false&utilization=false",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/datasource/blocks/blocks_without_utilization_and_vnet.json").String()), nil
+		})
+	httpmock.RegisterResponder("POST", "https://mockedHost.azurewebsites.net/api/tools/nextAvailableVNet",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/resource/reservation/next_available_vnet.json").String()), nil
+		})
 	httpmock.RegisterResponder("POST", "https://mockedHost.azurewebsites.net/api/spaces/au/reservations",
 		func(req *http.Request) (*http.Response, error) {
 			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/resource/reservation/new_reservation.json").String()), nil
@@ -25,9 +38,9 @@ func TestAccReservationResource(t *testing.T) {
 		func(req *http.Request) (*http.Response, error) {
 			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/resource/reservation/spaces_with_new_reservation_info.json").String()), nil
 		})
-	httpmock.RegisterResponder("GET", "https://mockedHost.azurewebsites.net/api/spaces/au/blocks/AustraliaSoutheast/reservations?settled=true",
+	httpmock.RegisterResponder("GET", "https://mockedHost.azurewebsites.net/api/spaces/au/blocks/AustraliaSoutheast/reservations/YYtppsvYQsRSBpZLsioZSV",
 		func(req *http.Request) (*http.Response, error) {
-			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/resource/reservation/reservations_with_new_reservation.json").String()), nil
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/resource/reservation/new_reservation.json").String()), nil
 		})
 
 	resource.Test(t, resource.TestCase{
@@ -54,6 +67,7 @@ func TestAccReservationResource(t *testing.T) {
 					resource.TestCheckResourceAttr("azureipam_reservation.test", "id", "YYtppsvYQsRSBpZLsioZSV"),
 					resource.TestCheckResourceAttr("azureipam_reservation.test", "block", "AustraliaSoutheast"),
 					resource.TestCheckResourceAttr("azureipam_reservation.test", "cidr", "10.83.2.0/23"),
+					resource.TestCheckResourceAttr("azureipam_reservation.test", "predicted_cidr", "10.83.2.0/23"),
 					resource.TestCheckResourceAttr("azureipam_reservation.test", "description", "acceptance-test"),
 					resource.TestCheckResourceAttrWith("azureipam_reservation.test", "created_on", func(value string) error {
 						expected, _ := time.Parse(time.RFC3339, "2024-09-07T06:21:42+02:00")
@@ -76,11 +90,421 @@ func TestAccReservationResource(t *testing.T) {
 				ResourceName:            "azureipam_reservation.test",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"reverse_search", "smallest_cidr", "blocks"},
+				ImportStateVerifyIgnore: []string{"reverse_search", "smallest_cidr", "blocks", "predicted_cidr"},
+			},
+			// ImportState testing with space and block, avoiding to search in all spaces
+			{
+				ResourceName:            "azureipam_reservation.test",
+				ImportState:             true,
+				ImportStateId:           "au/AustraliaSoutheast/YYtppsvYQsRSBpZLsioZSV",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"reverse_search", "smallest_cidr", "blocks", "predicted_cidr"},
+			},
+			// Update NOT ALLOWED by provider, only the timeouts can be changed keeping the computed attributes
+			{
+				Config: testAccProviderConfig + `resource "azureipam_reservation" "test" {
+					space          = "au"
+					blocks         = ["AustraliaSoutheast", "AustraliaEast"]
+					size           = 23
+					description    = "acceptance-test"
+					reverse_search = true
+					smallest_cidr  = true
+					timeouts {
+						read = "10m"
+					}
+				}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("azureipam_reservation.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("azureipam_reservation.test", "id", "YYtppsvYQsRSBpZLsioZSV"),
+					resource.TestCheckResourceAttr("azureipam_reservation.test", "cidr", "10.83.2.0/23"),
+					resource.TestCheckResourceAttr("azureipam_reservation.test", "status", "wait"),
+					resource.TestCheckResourceAttr("azureipam_reservation.test", "tags.X-IPAM-RES-ID", "YYtppsvYQsRSBpZLsioZSV"),
+					resource.TestCheckResourceAttr("azureipam_reservation.test", "timeouts.read", "10m"),
+				),
 			},
-			// Update  NOT ALLOWED by provider
 
 			// Delete testing automatically occurs in TestCase
 		},
 	})
 }
+
+func TestAccReservationResourceDeletedOutsideTerraform(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("GET", "https://mockedHost.azurewebsites.net/api/spaces/au/blocks?expand=false&utilization=false",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/datasource/blocks/blocks_without_utilization_and_vnet.json").String()), nil
+		})
+	httpmock.RegisterResponder("POST", "https://mockedHost.azurewebsites.net/api/tools/nextAvailableVNet",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/resource/reservation/next_available_vnet.json").String()), nil
+		})
+	deleted := false
+	httpmock.RegisterResponder("POST", "https://mockedHost.azurewebsites.net/api/spaces/au/reservations",
+		func(req *http.Request) (*http.Response, error) {
+			deleted = false
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/resource/reservation/new_reservation.json").String()), nil
+		})
+	httpmock.RegisterResponder("DELETE", "https://mockedHost.azurewebsites.net/api/spaces/au/blocks/AustraliaSoutheast/reservations",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, ""), nil
+		})
+	httpmock.RegisterResponder("GET", "https://mockedHost.azurewebsites.net/api/spaces/au/blocks/AustraliaSoutheast/reservations/YYtppsvYQsRSBpZLsioZSV",
+		func(req *http.Request) (*http.Response, error) {
+			if deleted {
+				return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/resource/reservation/cancelled_reservation.json").String()), nil
+			}
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/resource/reservation/new_reservation.json").String()), nil
+		})
+
+	config := testAccProviderConfig + `resource "azureipam_reservation" "test" {
+		space          = "au"
+		blocks         = ["AustraliaSoutheast", "AustraliaEast"]
+		size           = 23
+		description    = "acceptance-test"
+	}`
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: config,
+				Check:  resource.TestCheckResourceAttr("azureipam_reservation.test", "id", "YYtppsvYQsRSBpZLsioZSV"),
+			},
+			// Deleted outside terraform, kept by the engine as cancelled, recreation must be planned
+			{
+				PreConfig:          func() { deleted = true },
+				Config:             config,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccReservationResourceUserTags(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("GET", "https://mockedHost.azurewebsites.net/api/spaces/au/blocks?expand=false&utilization=false",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/datasource/blocks/blocks_without_utilization_and_vnet.json").String()), nil
+		})
+	httpmock.RegisterResponder("POST", "https://mockedHost.azurewebsites.net/api/tools/nextAvailableVNet",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/resource/reservation/next_available_vnet.json").String()), nil
+		})
+	httpmock.RegisterMatcherResponder("POST", "https://mockedHost.azurewebsites.net/api/spaces/au/reservations",
+		httpmock.BodyContainsString(`"tags":{"cost-center":"1234","owner":"network-team"}`),
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/resource/reservation/new_reservation_with_user_tags.json").String()), nil
+		})
+	httpmock.RegisterResponder("DELETE", "https://mockedHost.azurewebsites.net/api/spaces/au/blocks/AustraliaSoutheast/reservations",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, ""), nil
+		})
+	reservation := "tests/resource/reservation/new_reservation_with_user_tags.json"
+	httpmock.RegisterResponder("GET", "https://mockedHost.azurewebsites.net/api/spaces/au/blocks/AustraliaSoutheast/reservations/YYtppsvYQsRSBpZLsioZSV",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File(reservation).String()), nil
+		})
+
+	config := testAccProviderConfig + `resource "azureipam_reservation" "test" {
+		space  = "au"
+		blocks = ["AustraliaSoutheast", "AustraliaEast"]
+		size   = 23
+		user_tags = {
+			owner         = "network-team"
+			"cost-center" = "1234"
+		}
+	}`
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					//Verify the user tags are returned with the auto-generated ones
+					resource.TestCheckResourceAttr("azureipam_reservation.test", "user_tags.%", "2"),
+					resource.TestCheckResourceAttr("azureipam_reservation.test", "tags.%", "3"),
+					resource.TestCheckResourceAttr("azureipam_reservation.test", "tags.X-IPAM-RES-ID", "YYtppsvYQsRSBpZLsioZSV"),
+					resource.TestCheckResourceAttr("azureipam_reservation.test", "tags.owner", "network-team"),
+					resource.TestCheckResourceAttr("azureipam_reservation.test", "tags.cost-center", "1234"),
+				),
+			},
+			// User tag removed outside terraform, its recreation must be planned
+			{
+				PreConfig:          func() { reservation = "tests/resource/reservation/reservation_without_owner_tag.json" },
+				Config:             config,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccReservationResourceCidr(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterMatcherResponder("POST", "https://mockedHost.azurewebsites.net/api/spaces/au/blocks/AustraliaSoutheast/reservations",
+		httpmock.BodyContainsString(`"cidr":"10.82.4.0/24"`),
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/resource/reservation_cidr/new_reservation.json").String()), nil
+		})
+	httpmock.RegisterResponder("DELETE", "https://mockedHost.azurewebsites.net/api/spaces/au/blocks/AustraliaSoutheast/reservations",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, ""), nil
+		})
+	httpmock.RegisterResponder("GET", "https://mockedHost.azurewebsites.net/api/spaces/au/blocks/AustraliaSoutheast/reservations/Etc4svKttPXMQyvCb9sjy2",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/resource/reservation_cidr/new_reservation.json").String()), nil
+		})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccProviderConfig + `resource "azureipam_reservation" "test" {
+					space       = "au"
+					blocks      = ["AustraliaSoutheast"]
+					cidr        = "10.82.4.0/24"
+					description = "acceptance-test"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					//Verify the requested cidr is reserved, without size
+					resource.TestCheckResourceAttr("azureipam_reservation.test", "id", "Etc4svKttPXMQyvCb9sjy2"),
+					resource.TestCheckResourceAttr("azureipam_reservation.test", "block", "AustraliaSoutheast"),
+					resource.TestCheckResourceAttr("azureipam_reservation.test", "cidr", "10.82.4.0/24"),
+					resource.TestCheckNoResourceAttr("azureipam_reservation.test", "size"),
+					resource.TestCheckResourceAttr("azureipam_reservation.test", "status", "wait"),
+				),
+			},
+			// ImportState testing, the size is calculated from the cidr
+			{
+				ResourceName:            "azureipam_reservation.test",
+				ImportState:             true,
+				ImportStateId:           "au/AustraliaSoutheast/Etc4svKttPXMQyvCb9sjy2",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"blocks", "size"},
+			},
+		},
+	})
+}
+
+func TestAccReservationResourceSizeOrCidr(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Neither size nor cidr
+			{
+				Config: testAccProviderConfig + `resource "azureipam_reservation" "test" {
+					space  = "au"
+					blocks = ["AustraliaSoutheast"]
+				}`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+			// Both size and cidr
+			{
+				Config: testAccProviderConfig + `resource "azureipam_reservation" "test" {
+					space  = "au"
+					blocks = ["AustraliaSoutheast"]
+					size   = 24
+					cidr   = "10.82.4.0/24"
+				}`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+			// Cidr with more than one block
+			{
+				Config: testAccProviderConfig + `resource "azureipam_reservation" "test" {
+					space  = "au"
+					blocks = ["AustraliaSoutheast", "AustraliaEast"]
+					cidr   = "10.82.4.0/24"
+				}`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("only allowed when a single block is specified"),
+			},
+		},
+	})
+}
+
+func TestAccReservationResourceMoveFromReservationCidr(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("POST", "https://mockedHost.azurewebsites.net/api/spaces/au/blocks/AustraliaSoutheast/reservations",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/resource/reservation_cidr/new_reservation.json").String()), nil
+		})
+	httpmock.RegisterResponder("DELETE", "https://mockedHost.azurewebsites.net/api/spaces/au/blocks/AustraliaSoutheast/reservations",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, ""), nil
+		})
+	httpmock.RegisterResponder("GET", "https://mockedHost.azurewebsites.net/api/spaces/au/blocks/AustraliaSoutheast/reservations/Etc4svKttPXMQyvCb9sjy2",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/resource/reservation_cidr/new_reservation.json").String()), nil
+		})
+
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create with the deprecated resource
+			{
+				Config: testAccProviderConfig + `resource "azureipam_reservation_cidr" "test" {
+					space         = "au"
+					block         = "AustraliaSoutheast"
+					specific_cidr = "10.82.4.0/24"
+					description   = "acceptance-test"
+				}`,
+			},
+			// Move to the reservation resource, without replacing it
+			{
+				Config: testAccProviderConfig + `resource "azureipam_reservation" "test" {
+					space       = "au"
+					blocks      = ["AustraliaSoutheast"]
+					cidr        = "10.82.4.0/24"
+					description = "acceptance-test"
+				}
+				moved {
+					from = azureipam_reservation_cidr.test
+					to   = azureipam_reservation.test
+				}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("azureipam_reservation.test", plancheck.ResourceActionNoop),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("azureipam_reservation.test", "id", "Etc4svKttPXMQyvCb9sjy2"),
+					resource.TestCheckResourceAttr("azureipam_reservation.test", "blocks.#", "1"),
+					resource.TestCheckResourceAttr("azureipam_reservation.test", "blocks.0", "AustraliaSoutheast"),
+					resource.TestCheckResourceAttr("azureipam_reservation.test", "cidr", "10.82.4.0/24"),
+					resource.TestCheckNoResourceAttr("azureipam_reservation.test", "size"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccReservationResourceSizeLargerThanBlocks(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("GET", "https://mockedHost.azurewebsites.net/api/spaces/au/blocks?expand=false&utilization=false",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/datasource/blocks/blocks_without_utilization_and_vnet.json").String()), nil
+		})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Size out of bounds
+			{
+				Config: testAccProviderConfig + `resource "azureipam_reservation" "test" {
+					space  = "au"
+					blocks = ["AustraliaSoutheast", "AustraliaEast"]
+					size   = 30
+				}`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Invalid Attribute Value"),
+			},
+			// Size larger than the /16 blocks
+			{
+				Config: testAccProviderConfig + `resource "azureipam_reservation" "test" {
+					space  = "au"
+					blocks = ["AustraliaSoutheast", "AustraliaEast"]
+					size   = 15
+				}`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Invalid Reservation Size"),
+			},
+			// Cidr with host bits set
+			{
+				Config: testAccProviderConfig + `resource "azureipam_reservation" "test" {
+					space  = "au"
+					blocks = ["AustraliaSoutheast"]
+					cidr   = "10.83.4.1/24"
+				}`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("has host bits set"),
+			},
+		},
+	})
+}
+
+func TestAccReservationResourceSizeLargerThanSmallestBlock(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("GET", "https://mockedHost.azurewebsites.net/api/spaces/au/blocks?expand=false&utilization=false",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/resource/reservation/blocks_different_sizes.json").String()), nil
+		})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Size that fits in the /16 block, but not in the /20 one
+			{
+				Config: testAccProviderConfig + `resource "azureipam_reservation" "test" {
+					space  = "au"
+					blocks = ["AustraliaSoutheast", "AustraliaEast"]
+					size   = 19
+				}`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("smallest candidate block AustraliaEast"),
+			},
+		},
+	})
+}
+func TestAccReservationResourcePredictedCidr(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("POST", "https://mockedHost.azurewebsites.net/api/tools/nextAvailableVNet",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/datasource/tools/next_available_vnet.json").String()), nil
+		})
+	httpmock.RegisterResponder("POST", "https://mockedHost.azurewebsites.net/api/spaces/au/reservations",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/resource/reservation/new_reservation.json").String()), nil
+		})
+	httpmock.RegisterResponder("DELETE", "https://mockedHost.azurewebsites.net/api/spaces/au/blocks/AustraliaSoutheast/reservations",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, ""), nil
+		})
+	httpmock.RegisterResponder("GET", "https://mockedHost.azurewebsites.net/api/spaces/au/blocks/AustraliaSoutheast/reservations/YYtppsvYQsRSBpZLsioZSV",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/resource/reservation/new_reservation.json").String()), nil
+		})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// The predicted range is shown in the plan
+			{
+				Config: testAccProviderConfig + `resource "azureipam_reservation" "test" {
+					space  = "au"
+					blocks = ["AustraliaSoutheast", "AustraliaEast"]
+					size   = 23
+				}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectKnownValue("azureipam_reservation.test", tfjsonpath.New("predicted_cidr"), knownvalue.StringExact("10.1.2.0/24")),
+						plancheck.ExpectUnknownValue("azureipam_reservation.test", tfjsonpath.New("cidr")),
+					},
+				},
+				// The assigned range differs from the prediction, that is kept
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("azureipam_reservation.test", "predicted_cidr", "10.1.2.0/24"),
+					resource.TestCheckResourceAttr("azureipam_reservation.test", "cidr", "10.83.2.0/23"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,324 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	ipamclient "terraform-provider-azureipam/ipamclient"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &reservationSettlementResource{}
+	_ resource.ResourceWithConfigure   = &reservationSettlementResource{}
+	_ resource.ResourceWithImportState = &reservationSettlementResource{}
+)
+
+// defaultSettlementPollInterval is the wait between reads of the reservation status, when not configured.
+const defaultSettlementPollInterval = 10 * time.Second
+
+// NewReservationSettlementResource is a helper function to simplify the provider implementation.
+func NewReservationSettlementResource() resource.Resource {
+	return &reservationSettlementResource{}
+}
+
+// reservationSettlementResourceModel maps the resource schema data.
+type reservationSettlementResourceModel struct {
+	Space        types.String      `tfsdk:"space"`
+	Block        types.String      `tfsdk:"block"`
+	Id           types.String      `tfsdk:"id"`
+	PollInterval types.Int64       `tfsdk:"poll_interval"`
+	Cidr         types.String      `tfsdk:"cidr"`
+	Status       types.String      `tfsdk:"status"`
+	SettledOn    timetypes.RFC3339 `tfsdk:"settled_on"`
+	SettledBy    types.String      `tfsdk:"settled_by"`
+	Timeouts     timeouts.Value    `tfsdk:"timeouts"`
+}
+
+// reservationSettlementResource is the resource implementation.
+type reservationSettlementResource struct {
+	client *ipamclient.Client
+}
+
+// Metadata returns the resource type name.
+func (r *reservationSettlementResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_reservation_settlement"
+}
+
+// Schema defines the schema for the resource.
+func (r *reservationSettlementResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "The reservation settlement resource waits until a reservation is settled, that happens when the IPAM application finds a virtual network with the 'X-IPAM-RES-ID' tag of the reservation. Make it depend on the virtual network to block the downstream resources until the reservation is fulfilled. Destroying it doesn't modify the reservation.",
+		Attributes: map[string]schema.Attribute{
+			"space": schema.StringAttribute{
+				Description: "Name of the space where the reservation is allocated. Changing this forces a new resource to be created.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"block": schema.StringAttribute{
+				Description: "Name of the block where the reservation is allocated. Changing this forces a new resource to be created.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"id": schema.StringAttribute{
+				Description: "The unique identifier of the reservation to wait for. Changing this forces a new resource to be created.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"poll_interval": schema.Int64Attribute{
+				Description: "Seconds to wait between each read of the reservation status, at least 1. Defaults to 10.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"cidr": schema.StringAttribute{
+				Description: "The assigned and reserved range, in cidr notation.",
+				Computed:    true,
+			},
+			"status": schema.StringAttribute{
+				Description: "Status of the reservation, 'fulfilled' once it has been settled.",
+				Computed:    true,
+			},
+			"settled_on": schema.StringAttribute{
+				CustomType:  timetypes.RFC3339Type{},
+				Description: "The date and time that the reservacion was settled.",
+				Computed:    true,
+			},
+			"settled_by": schema.StringAttribute{
+				Description: "Email or identification of user that settled the reservation.",
+				Computed:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+			}),
+		},
+	}
+}
+
+// Create waits until the reservation is settled.
+func (r *reservationSettlementResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan reservationSettlementResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	pollInterval := defaultSettlementPollInterval
+	if !plan.PollInterval.IsNull() {
+		pollInterval = time.Duration(plan.PollInterval.ValueInt64()) * time.Second
+	}
+
+	//poll the reservation until it leaves the wait status
+	var reservation *ipamclient.Reservation
+	for {
+		var err error
+		reservation, err = r.client.GetReservation(ctx,
+			plan.Space.ValueString(),
+			plan.Block.ValueString(),
+			plan.Id.ValueString(),
+		)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error waiting for reservation settlement",
+				"Could not read reservation with id "+plan.Id.ValueString()+", unexpected error: "+err.Error(),
+			)
+			return
+		}
+		if reservation.Status != ipamclient.ReservationStatusWait {
+			break
+		}
+
+		tflog.Debug(ctx, "Waiting for reservation settlement", map[string]any{"id": plan.Id.ValueString(), "status": reservation.Status})
+		select {
+		case <-ctx.Done():
+			resp.Diagnostics.AddError(
+				"Error waiting for reservation settlement",
+				fmt.Sprintf("The reservation with id %s is still in %s status after %s. Ensure that the virtual network has been created with the 'X-IPAM-RES-ID' tag of the reservation.", plan.Id.ValueString(), reservation.Status, createTimeout),
+			)
+			return
+		case <-time.After(pollInterval):
+		}
+	}
+
+	resp.Diagnostics.Append(checkSettlementStatus(reservation)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	flattenReservationSettlement(reservation, &plan)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read resource information.
+func (r *reservationSettlementResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state reservationSettlementResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	//read reservation
+	reservation, err := readReservation(ctx, r.client, state.Space, state.Block, state.Id)
+	if ipamclient.IsNotFound(err) {
+		//deleted outside terraform, remove from state to plan its recreation
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading AzureIpam Reservation",
+			"Could not read AzureIpam Reservation with id "+state.Id.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Overwrite items with refreshed state
+	state.Space = types.StringValue(reservation.Space)
+	state.Block = types.StringValue(reservation.Block)
+	flattenReservationSettlement(reservation, &state)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update not allowed, only the poll interval and the timeouts are copied from the plan to the current state,
+// keeping the settled reservation attributes that are unknown in the plan.
+func (n *reservationSettlementResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var state reservationSettlementResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var plan reservationSettlementResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.PollInterval = plan.PollInterval
+	state.Timeouts = plan.Timeouts
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Delete only removes the resource from the state, the reservation is not modified.
+func (r *reservationSettlementResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *reservationSettlementResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ipamclient.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *azureipam.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *reservationSettlementResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importReservationState(ctx, req, resp)
+}
+
+// checkSettlementStatus reports the reservations cancelled or failed, and warns about the ones settled with warnings.
+func checkSettlementStatus(reservation *ipamclient.Reservation) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	switch {
+	case reservation.Status == ipamclient.ReservationStatusFulfilled:
+	case strings.HasPrefix(reservation.Status, "warn"):
+		diags.AddWarning(
+			"Reservation settled with warnings",
+			fmt.Sprintf("The reservation with id %s has been settled with status %s.", reservation.Id, reservation.Status),
+		)
+	default:
+		diags.AddError(
+			"Error waiting for reservation settlement",
+			fmt.Sprintf("The reservation with id %s will not be settled, its status is %s.", reservation.Id, reservation.Status),
+		)
+	}
+
+	return diags
+}
+
+func flattenReservationSettlement(reservation *ipamclient.Reservation, model *reservationSettlementResourceModel) {
+	model.Id = types.StringValue(reservation.Id)
+	model.Cidr = types.StringValue(reservation.Cidr)
+	model.Status = types.StringValue(reservation.Status)
+	if reservation.SettledOn == nil {
+		model.SettledOn = timetypes.NewRFC3339Null()
+	} else {
+		model.SettledOn = timetypes.NewRFC3339TimeValue(time.Unix(int64(*reservation.SettledOn), 0))
+	}
+	if reservation.SettledBy == nil {
+		model.SettledBy = types.StringNull()
+	} else {
+		model.SettledBy = types.StringValue(*reservation.SettledBy)
+	}
+}
@@ -0,0 +1,160 @@
+package provider
+
+import (
+	"errors"
+	"net/http"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/jarcoal/httpmock"
+)
+
+func TestAccReservationSettlementResource(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	reads := 0
+	httpmock.RegisterResponder("GET", "https://mockedHost.azurewebsites.net/api/spaces/au/blocks/AustraliaSoutheast/reservations/YYtppsvYQsRSBpZLsioZSV",
+		func(req *http.Request) (*http.Response, error) {
+			reads++
+			//the first read returns the reservation still waiting for the vnet
+			if reads == 1 {
+				return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/resource/reservation/new_reservation.json").String()), nil
+			}
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/resource/reservation_settlement/settled_reservation.json").String()), nil
+		})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccProviderConfig + `resource "azureipam_reservation_settlement" "test" {
+					space         = "au"
+					block         = "AustraliaSoutheast"
+					id            = "YYtppsvYQsRSBpZLsioZSV"
+					poll_interval = 1
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					//Verify common attributes to ensure that all are set
+					resource.TestCheckResourceAttr("azureipam_reservation_settlement.test", "space", "au"),
+					resource.TestCheckResourceAttr("azureipam_reservation_settlement.test", "block", "AustraliaSoutheast"),
+					resource.TestCheckResourceAttr("azureipam_reservation_settlement.test", "id", "YYtppsvYQsRSBpZLsioZSV"),
+					resource.TestCheckResourceAttr("azureipam_reservation_settlement.test", "poll_interval", "1"),
+					resource.TestCheckResourceAttr("azureipam_reservation_settlement.test", "cidr", "10.83.2.0/23"),
+					resource.TestCheckResourceAttr("azureipam_reservation_settlement.test", "status", "fulfilled"),
+					resource.TestCheckResourceAttrWith("azureipam_reservation_settlement.test", "settled_on", func(value string) error {
+						expected, _ := time.Parse(time.RFC3339, "2024-09-07T06:31:42+02:00")
+						current, _ := time.Parse(time.RFC3339, value)
+						if current.Equal(expected) {
+							return nil
+						}
+						return errors.New("expected " + expected.String() + " got " + current.String())
+					}),
+					resource.TestCheckResourceAttr("azureipam_reservation_settlement.test", "settled_by", "spn:3fb5c6d7-8e9f-4a1b-b2c3-d4e5f6a7b8c9"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "azureipam_reservation_settlement.test",
+				ImportState:             true,
+				ImportStateId:           "au/AustraliaSoutheast/YYtppsvYQsRSBpZLsioZSV",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"poll_interval"},
+			},
+			// Update NOT ALLOWED by provider, only the poll interval can be changed keeping the settled attributes
+			{
+				Config: testAccProviderConfig + `resource "azureipam_reservation_settlement" "test" {
+					space         = "au"
+					block         = "AustraliaSoutheast"
+					id            = "YYtppsvYQsRSBpZLsioZSV"
+					poll_interval = 5
+				}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("azureipam_reservation_settlement.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("azureipam_reservation_settlement.test", "poll_interval", "5"),
+					resource.TestCheckResourceAttr("azureipam_reservation_settlement.test", "cidr", "10.83.2.0/23"),
+					resource.TestCheckResourceAttr("azureipam_reservation_settlement.test", "status", "fulfilled"),
+					resource.TestCheckResourceAttr("azureipam_reservation_settlement.test", "settled_by", "spn:3fb5c6d7-8e9f-4a1b-b2c3-d4e5f6a7b8c9"),
+				),
+			},
+
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccReservationSettlementResourceTimeout(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("GET", "https://mockedHost.azurewebsites.net/api/spaces/au/blocks/AustraliaSoutheast/reservations/YYtppsvYQsRSBpZLsioZSV",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/resource/reservation/new_reservation.json").String()), nil
+		})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig + `resource "azureipam_reservation_settlement" "test" {
+					space         = "au"
+					block         = "AustraliaSoutheast"
+					id            = "YYtppsvYQsRSBpZLsioZSV"
+					poll_interval = 1
+					timeouts {
+						create = "3s"
+					}
+				}`,
+				ExpectError: regexp.MustCompile("Error waiting for reservation settlement"),
+			},
+		},
+	})
+}
+
+func TestAccReservationSettlementResourceCancelled(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("GET", "https://mockedHost.azurewebsites.net/api/spaces/au/blocks/AustraliaSoutheast/reservations/YYtppsvYQsRSBpZLsioZSV",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/resource/reservation_settlement/cancelled_reservation.json").String()), nil
+		})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig + `resource "azureipam_reservation_settlement" "test" {
+					space = "au"
+					block = "AustraliaSoutheast"
+					id    = "YYtppsvYQsRSBpZLsioZSV"
+				}`,
+				ExpectError: regexp.MustCompile("cancelledByUser"),
+			},
+		},
+	})
+}
+
+func TestAccReservationSettlementResourceInvalidPollInterval(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// The poll interval is validated when planning
+			{
+				Config: testAccProviderConfig + `resource "azureipam_reservation_settlement" "test" {
+					space         = "au"
+					block         = "AustraliaSoutheast"
+					id            = "YYtppsvYQsRSBpZLsioZSV"
+					poll_interval = 0
+				}`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Invalid Attribute Value"),
+			},
+		},
+	})
+}
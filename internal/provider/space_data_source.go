@@ -237,7 +237,7 @@ func (d *spaceDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	// Read Terraform configuration state into the model
 	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
 
-	space, err := d.client.GetSpace(
+	space, err := d.client.GetSpace(ctx,
 		state.Name.ValueString(),
 		state.Expand.ValueBool(),
 		state.AppendUtilization.ValueBool(),
@@ -3,6 +3,7 @@ package provider
 import (
 	"errors"
 	"net/http"
+	"regexp"
 	"testing"
 	"time"
 
@@ -238,3 +239,25 @@ func TestAccSpaceWithUtilizationAndVnetDataSource(t *testing.T) {
 		},
 	})
 }
+
+func TestAccSpaceNotFoundDataSource(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder("GET", "https://mockedHost.azurewebsites.net/api/spaces/notexisting?expand=false&utilization=false",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusBadRequest, `{"error":"Invalid space name."}`), nil
+		})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccProviderConfig + `data "azureipam_space" "test" {
+				    name = "notexisting"
+				}`,
+				ExpectError: regexp.MustCompile(`failed with status: 400, error: Invalid space name\.`),
+			},
+		},
+	})
+}
@@ -6,6 +6,7 @@ import (
 
 	ipamclient "terraform-provider-azureipam/ipamclient"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -26,8 +27,9 @@ func NewSpaceResource() resource.Resource {
 
 // spaceResourceModel maps the resource schema data.
 type spaceResourceModel struct {
-	Name        types.String `tfsdk:"name"`
-	Description types.String `tfsdk:"description"`
+	Name        types.String   `tfsdk:"name"`
+	Description types.String   `tfsdk:"description"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
 }
 
 // spaceResource is the resource implementation.
@@ -41,7 +43,7 @@ func (r *spaceResource) Metadata(_ context.Context, req resource.MetadataRequest
 }
 
 // Schema defines the schema for the resource.
-func (r *spaceResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *spaceResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "The space resource allows you to create a IPAM space.",
 		Attributes: map[string]schema.Attribute{
@@ -54,6 +56,14 @@ func (r *spaceResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				Required:    true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -67,7 +77,15 @@ func (r *spaceResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	space, err := r.client.CreateSpace(
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	space, err := r.client.CreateSpace(ctx,
 		plan.Name.ValueString(),
 		plan.Description.ValueString(),
 	)
@@ -100,12 +118,25 @@ func (r *spaceResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	//read space
-	space, err := r.client.GetSpace(
+	space, err := r.client.GetSpace(ctx,
 		state.Name.ValueString(),
 		false,
 		false,
 	)
+	if ipamclient.IsNotFound(err) {
+		//deleted outside terraform, remove from state to plan its recreation
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading AzureIpam Space",
@@ -143,8 +174,16 @@ func (n *spaceResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	//Modify the space resource
-	space, err := n.client.UpdateSpace(
+	space, err := n.client.UpdateSpace(ctx,
 		state.Name.ValueString(),
 		plan.Name.ValueStringPointer(),
 		plan.Description.ValueStringPointer(),
@@ -177,8 +216,16 @@ func (r *spaceResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	// Delete existing space
-	err := r.client.DeleteSpace(
+	err := r.client.DeleteSpace(ctx,
 		state.Name.ValueString(),
 		true,
 	)
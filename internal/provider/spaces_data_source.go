@@ -241,7 +241,7 @@ func (d *spacesDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	// Read Terraform configuration state into the model
 	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
 
-	spaces, err := d.client.GetSpaces(
+	spaces, err := d.client.GetSpaces(ctx,
 		state.Expand.ValueBool(),
 		state.AppendUtilization.ValueBool(),
 	)
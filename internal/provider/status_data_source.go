@@ -0,0 +1,108 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	ipamclient "terraform-provider-azureipam/ipamclient"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &statusDataSource{}
+	_ datasource.DataSourceWithConfigure = &statusDataSource{}
+)
+
+// NewStatusDataSource is a helper function to simplify the provider implementation.
+func NewStatusDataSource() datasource.DataSource {
+	return &statusDataSource{}
+}
+
+// statusDataSource is the data source implementation.
+type statusDataSource struct {
+	client *ipamclient.Client
+}
+
+// statusDataSourceModel maps the data source schema data.
+type statusDataSourceModel struct {
+	Status    types.String `tfsdk:"status"`
+	Version   types.String `tfsdk:"version"`
+	Supported types.Bool   `tfsdk:"supported"`
+}
+
+// Metadata returns the data source type name.
+func (d *statusDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_status"
+}
+
+// Schema defines the schema for the data source.
+func (d *statusDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "The status data source allows you to retrieve the status and version of the IPAM engine.",
+		Attributes: map[string]schema.Attribute{
+			"status": schema.StringAttribute{
+				Description: "Status reported by the IPAM engine, e.g. `OK`.",
+				Computed:    true,
+			},
+			"version": schema.StringAttribute{
+				Description: "Version of the IPAM engine.",
+				Computed:    true,
+			},
+			"supported": schema.BoolAttribute{
+				Description: "Indicates if the version of the IPAM engine is supported by the provider, or `false` if it can't be determined.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *statusDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state statusDataSourceModel
+
+	status, err := d.client.GetStatus(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read AzureIpam Status",
+			err.Error(),
+		)
+		return
+	}
+
+	// Map response body to model
+	state.Status = types.StringValue(status.Status)
+	state.Version = types.StringValue(status.Version)
+	cmp, err := ipamclient.CompareEngineVersions(status.Version, ipamclient.MinEngineVersion)
+	state.Supported = types.BoolValue(err == nil && cmp >= 0)
+
+	// Set state
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *statusDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*ipamclient.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *azureipam.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
@@ -0,0 +1,187 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource = &subnetPlanDataSource{}
+)
+
+// NewSubnetPlanDataSource is a helper function to simplify the provider implementation.
+func NewSubnetPlanDataSource() datasource.DataSource {
+	return &subnetPlanDataSource{}
+}
+
+// subnetPlanDataSource is the data source implementation.
+type subnetPlanDataSource struct{}
+
+// subnetPlanDataSourceModel maps the data source schema data.
+type subnetPlanDataSourceModel struct {
+	Cidr        types.String             `tfsdk:"cidr"`
+	Subnets     []subnetPlanRequestModel `tfsdk:"subnets"`
+	Allocations []subnetPlanSubnetModel  `tfsdk:"allocations"`
+	Cidrs       types.Map                `tfsdk:"cidrs"`
+}
+
+// subnetPlanRequestModel maps the requested subnets schema data.
+type subnetPlanRequestModel struct {
+	Name         types.String `tfsdk:"name"`
+	PrefixLength types.Int32  `tfsdk:"prefix_length"`
+}
+
+// subnetPlanSubnetModel maps the allocated subnets schema data.
+type subnetPlanSubnetModel struct {
+	Name         types.String `tfsdk:"name"`
+	PrefixLength types.Int32  `tfsdk:"prefix_length"`
+	Cidr         types.String `tfsdk:"cidr"`
+}
+
+// Metadata returns the data source type name.
+func (d *subnetPlanDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_subnet_plan"
+}
+
+// Schema defines the schema for the data source.
+func (d *subnetPlanDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "The subnet plan data source allows you to split a range, as the cidr of a reservation, into the subnets of a virtual network. The subnets are allocated in the order specified, each one at the first free address aligned to its size, so they never overlap. It doesn't perform any request to the IPAM application.",
+		Attributes: map[string]schema.Attribute{
+			"cidr": schema.StringAttribute{
+				Description: "The range to split, in cidr notation.",
+				Required:    true,
+			},
+			"subnets": schema.ListNestedAttribute{
+				Description: "The ordered list of subnets to allocate.",
+				Required:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "Name of the subnet, unique in the list.",
+							Required:    true,
+						},
+						"prefix_length": schema.Int32Attribute{
+							Description: "Integer value to indicate the subnet mask bits, which defines the size of the subnet (example 26 for a /26 subnet).",
+							Required:    true,
+						},
+					},
+				},
+			},
+			"allocations": schema.ListNestedAttribute{
+				Description: "The allocated subnets, in the same order as requested.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "Name of the subnet.",
+							Computed:    true,
+						},
+						"prefix_length": schema.Int32Attribute{
+							Description: "The subnet mask bits of the subnet.",
+							Computed:    true,
+						},
+						"cidr": schema.StringAttribute{
+							Description: "The range allocated to the subnet, in cidr notation.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"cidrs": schema.MapAttribute{
+				Description: "The range allocated to each subnet, in cidr notation, by subnet name.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *subnetPlanDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state subnetPlanDataSourceModel
+
+	// Read Terraform configuration state into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	parent, err := parseRange(state.Cidr.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("cidr"),
+			"Invalid Subnet Plan Range",
+			err.Error(),
+		)
+		return
+	}
+
+	names := make(map[string]bool, len(state.Subnets))
+	requests := make([]subnetRequest, 0, len(state.Subnets))
+	for i, subnet := range state.Subnets {
+		if names[subnet.Name.ValueString()] {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("subnets").AtListIndex(i).AtName("name"),
+				"Duplicated Subnet Name",
+				fmt.Sprintf("The subnet name %s is specified more than once, the names must be unique.", subnet.Name.ValueString()),
+			)
+		}
+		names[subnet.Name.ValueString()] = true
+		requests = append(requests, subnetRequest{Name: subnet.Name.ValueString(), Bits: int(subnet.PrefixLength.ValueInt32())})
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	allocated, err := allocateSubnets(parent, requests)
+	var allocationErr *subnetAllocationError
+	if errors.As(err, &allocationErr) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("subnets").AtListIndex(allocationErr.Index).AtName("prefix_length"),
+			"Subnet Does Not Fit",
+			"Unable to allocate the subnet, "+allocationErr.Error()+".",
+		)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Allocate Subnets",
+			err.Error(),
+		)
+		return
+	}
+
+	// Map allocation to model
+	cidrs := make(map[string]string, len(allocated))
+	state.Allocations = []subnetPlanSubnetModel{}
+	for i, subnet := range allocated {
+		state.Allocations = append(state.Allocations, subnetPlanSubnetModel{
+			Name:         state.Subnets[i].Name,
+			PrefixLength: state.Subnets[i].PrefixLength,
+			Cidr:         types.StringValue(subnet.String()),
+		})
+		cidrs[state.Subnets[i].Name.ValueString()] = subnet.String()
+	}
+	var diags diag.Diagnostics
+	state.Cidrs, diags = types.MapValueFrom(ctx, types.StringType, cidrs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
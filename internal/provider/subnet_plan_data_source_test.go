@@ -0,0 +1,65 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSubnetPlanDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccProviderConfig + `data "azureipam_subnet_plan" "test" {
+					cidr = "10.82.4.0/22"
+					subnets = [
+						{ name = "gateway", prefix_length = 27 },
+						{ name = "app", prefix_length = 24 },
+						{ name = "data", prefix_length = 26 },
+						{ name = "web", prefix_length = 24 },
+					]
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					//Verify the allocation order and alignment
+					resource.TestCheckResourceAttr("data.azureipam_subnet_plan.test", "allocations.#", "4"),
+					resource.TestCheckResourceAttr("data.azureipam_subnet_plan.test", "allocations.0.name", "gateway"),
+					resource.TestCheckResourceAttr("data.azureipam_subnet_plan.test", "allocations.0.cidr", "10.82.4.0/27"),
+					resource.TestCheckResourceAttr("data.azureipam_subnet_plan.test", "allocations.1.name", "app"),
+					resource.TestCheckResourceAttr("data.azureipam_subnet_plan.test", "allocations.1.cidr", "10.82.5.0/24"),
+					resource.TestCheckResourceAttr("data.azureipam_subnet_plan.test", "allocations.2.name", "data"),
+					resource.TestCheckResourceAttr("data.azureipam_subnet_plan.test", "allocations.2.prefix_length", "26"),
+					resource.TestCheckResourceAttr("data.azureipam_subnet_plan.test", "allocations.2.cidr", "10.82.4.64/26"),
+					resource.TestCheckResourceAttr("data.azureipam_subnet_plan.test", "allocations.3.name", "web"),
+					resource.TestCheckResourceAttr("data.azureipam_subnet_plan.test", "allocations.3.cidr", "10.82.6.0/24"),
+					//Verify the cidrs by name
+					resource.TestCheckResourceAttr("data.azureipam_subnet_plan.test", "cidrs.%", "4"),
+					resource.TestCheckResourceAttr("data.azureipam_subnet_plan.test", "cidrs.app", "10.82.5.0/24"),
+					resource.TestCheckResourceAttr("data.azureipam_subnet_plan.test", "cidrs.gateway", "10.82.4.0/27"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSubnetPlanDataSourceNotFit(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccProviderConfig + `data "azureipam_subnet_plan" "test" {
+					cidr = "10.82.4.0/24"
+					subnets = [
+						{ name = "app", prefix_length = 25 },
+						{ name = "data", prefix_length = 26 },
+						{ name = "web", prefix_length = 25 },
+					]
+				}`,
+				ExpectError: regexp.MustCompile("there is not enough space left in 10.82.4.0/24 to allocate web as a /25"),
+			},
+		},
+	})
+}
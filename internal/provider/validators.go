@@ -0,0 +1,89 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// prefix length bounds of the ranges managed by the IPAM application, that only supports IPv4.
+const (
+	minPrefixLength         = 8
+	maxVnetPrefixLength     = 29
+	maxExternalPrefixLength = 32
+)
+
+// uuidRegex matches the format of the azure subscription ids and the object ids of users and service principals.
+var uuidRegex = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+// Ensure the implementation satisfies the expected interfaces.
+var _ validator.String = cidrValidator{}
+
+// cidrValidator validates that the value is an IPv4 network range in cidr notation, without host bits, and with
+// a prefix length between the configured bounds.
+type cidrValidator struct {
+	minPrefixLength int
+	maxPrefixLength int
+}
+
+// ipv4CidrValidator returns a validator of IPv4 network ranges with a prefix length between min and max.
+func ipv4CidrValidator(min int, max int) validator.String {
+	return cidrValidator{
+		minPrefixLength: min,
+		maxPrefixLength: max,
+	}
+}
+
+// Description returns a plain text description of the validator's behavior.
+func (v cidrValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("value must be an IPv4 network range in cidr notation, with a prefix length between %d and %d", v.minPrefixLength, v.maxPrefixLength)
+}
+
+// MarkdownDescription returns a markdown formatted description of the validator's behavior.
+func (v cidrValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateString performs the validation.
+func (v cidrValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	value := req.ConfigValue.ValueString()
+
+	prefix, err := netip.ParsePrefix(value)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid CIDR",
+			fmt.Sprintf("%q is not a valid range in cidr notation, e.g. 10.0.0.0/24.", value),
+		)
+		return
+	}
+	if !prefix.Addr().Is4() {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid CIDR",
+			fmt.Sprintf("%q is not an IPv4 range, only IPv4 ranges are supported by the IPAM application.", value),
+		)
+		return
+	}
+	if prefix.Bits() < v.minPrefixLength || prefix.Bits() > v.maxPrefixLength {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid CIDR",
+			fmt.Sprintf("The prefix length of %q must be between %d and %d, got %d.", value, v.minPrefixLength, v.maxPrefixLength, prefix.Bits()),
+		)
+		return
+	}
+	if prefix != prefix.Masked() {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid CIDR",
+			fmt.Sprintf("%q has host bits set, the network address of the range is %s.", value, prefix.Masked()),
+		)
+	}
+}
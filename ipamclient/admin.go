@@ -0,0 +1,110 @@
+package azureipamclient
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// Admin types supported by the IPAM engine
+const (
+	AdminTypeUser      = "User"
+	AdminTypePrincipal = "Principal"
+)
+
+// GetAdmins - Returns the list of the IPAM administrators.
+func (c *Client) GetAdmins(ctx context.Context) (*[]Admin, error) {
+	//prepare request
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/api/admin/admins", c.HostURL), nil)
+	if err != nil {
+		return nil, err
+	}
+	response, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	//process response
+	var admins []Admin
+	err = json.Unmarshal(response, &admins)
+	if err != nil {
+		return nil, err
+	}
+
+	return &admins, nil
+}
+
+// GetAdmin - Returns a specific IPAM administrator by its object id.
+func (c *Client) GetAdmin(ctx context.Context, objectId string) (*Admin, error) {
+
+	//Read all the administrators
+	admins, err := c.GetAdmins(ctx)
+	if err != nil {
+		return nil, err
+	}
+	//find the administrator by object id, and returns
+	for _, admin := range *admins {
+		if strings.EqualFold(admin.Id, objectId) {
+			return &admin, nil
+		}
+	}
+
+	return nil, fmt.Errorf("admin %s %w", objectId, ErrNotFound)
+}
+
+// CreateAdmin - Adds a user or service principal as IPAM administrator.
+func (c *Client) CreateAdmin(ctx context.Context, adminType string, objectId string, name string, email *string) (*Admin, error) {
+
+	//construct body
+	request := &Admin{
+		Type:  adminType,
+		Id:    objectId,
+		Name:  name,
+		Email: email,
+	}
+	rb, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+
+	//prepare request
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/api/admin/admins", c.HostURL), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+	_, err = c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	//Create return object
+	ret, err := c.GetAdmin(ctx, objectId)
+	if err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
+
+// DeleteAdmin - Removes an IPAM administrator by its object id.
+func (c *Client) DeleteAdmin(ctx context.Context, objectId string) error {
+	//prepare request
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/api/admin/admins/%s", c.HostURL, objectId), nil)
+	if err != nil {
+		return err
+	}
+	response, err := c.doRequest(req)
+	if err != nil {
+		return err
+	}
+
+	//process response
+	if string(response) != "" {
+		return errors.New(string(response))
+	}
+
+	return nil
+}
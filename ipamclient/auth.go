@@ -0,0 +1,359 @@
+package azureipamclient
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+)
+
+const (
+	// DefaultAuthorityHost - Microsoft Entra ID authority used when no other is configured
+	DefaultAuthorityHost = "https://login.microsoftonline.com"
+	// DefaultMsiEndpoint - Azure Instance Metadata Service endpoint used to request managed identity tokens
+	DefaultMsiEndpoint = "http://169.254.169.254/metadata/identity/oauth2/token"
+)
+
+// AuthConfig - Settings used to acquire access tokens for the IPAM engine application from Microsoft Entra ID
+type AuthConfig struct {
+	EngineAppId               string
+	TenantId                  string
+	ClientId                  string
+	ClientSecret              string
+	ClientCertificatePath     string
+	ClientCertificatePassword string
+	OidcToken                 string
+	OidcTokenFilePath         string
+	UseMsi                    bool
+	MsiEndpoint               string
+	AuthorityHost             string
+}
+
+// accessToken - An access token and the time it expires
+type accessToken struct {
+	Token     string
+	ExpiresOn time.Time
+}
+
+// tokenCredential - Any mechanism able to acquire an access token for the IPAM engine application
+type tokenCredential interface {
+	getToken(ctx context.Context, c *Client) (*accessToken, error)
+}
+
+// internal Models
+type entraTokenResponse struct {
+	AccessToken string      `json:"access_token"`
+	ExpiresIn   json.Number `json:"expires_in"`
+	ExpiresOn   json.Number `json:"expires_on"`
+}
+type entraErrorResponse struct {
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+}
+
+// newTokenCredential - Select the credential to be used from the supplied settings. The first configured
+// mechanism is used in this order: client secret, client certificate, OIDC token and managed identity.
+func newTokenCredential(auth *AuthConfig) (tokenCredential, error) {
+	if auth.EngineAppId == "" {
+		return nil, errors.New("the engine application id is required to request access tokens")
+	}
+	authorityHost := auth.AuthorityHost
+	if authorityHost == "" {
+		authorityHost = DefaultAuthorityHost
+	}
+	tokenEndpoint := fmt.Sprintf("%s/%s/oauth2/v2.0/token", strings.TrimSuffix(authorityHost, "/"), auth.TenantId)
+	scope := fmt.Sprintf("api://%s/.default", auth.EngineAppId)
+
+	switch {
+	case auth.ClientSecret != "":
+		if auth.TenantId == "" || auth.ClientId == "" {
+			return nil, errors.New("tenant id and client id are required to authenticate with a client secret")
+		}
+		return &clientSecretCredential{
+			tokenEndpoint: tokenEndpoint,
+			scope:         scope,
+			clientId:      auth.ClientId,
+			clientSecret:  auth.ClientSecret,
+		}, nil
+	case auth.ClientCertificatePath != "":
+		if auth.TenantId == "" || auth.ClientId == "" {
+			return nil, errors.New("tenant id and client id are required to authenticate with a client certificate")
+		}
+		data, err := os.ReadFile(auth.ClientCertificatePath)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read the client certificate: %w", err)
+		}
+		//PEM and PKCS#12 files are supported, including the certificate chain
+		certs, key, err := azidentity.ParseCertificates(data, []byte(auth.ClientCertificatePassword))
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode the client certificate: %w", err)
+		}
+		rsaKey, ok := key.(*rsa.PrivateKey)
+		if !ok {
+			return nil, errors.New("the client certificate private key must be a RSA key")
+		}
+		cert := leafCertificate(certs, rsaKey)
+		if cert == nil {
+			return nil, errors.New("the client certificate file doesn't contain the certificate of the private key")
+		}
+		return &clientCertificateCredential{
+			tokenEndpoint: tokenEndpoint,
+			scope:         scope,
+			clientId:      auth.ClientId,
+			certificate:   cert,
+			privateKey:    rsaKey,
+		}, nil
+	case auth.OidcToken != "" || auth.OidcTokenFilePath != "":
+		if auth.TenantId == "" || auth.ClientId == "" {
+			return nil, errors.New("tenant id and client id are required to authenticate with an OIDC token")
+		}
+		return &oidcCredential{
+			tokenEndpoint: tokenEndpoint,
+			scope:         scope,
+			clientId:      auth.ClientId,
+			token:         auth.OidcToken,
+			tokenFilePath: auth.OidcTokenFilePath,
+		}, nil
+	case auth.UseMsi:
+		return &managedIdentityCredential{
+			endpoint: auth.MsiEndpoint,
+			resource: fmt.Sprintf("api://%s", auth.EngineAppId),
+			clientId: auth.ClientId,
+		}, nil
+	}
+
+	return nil, errors.New("no authentication method configured, one of client secret, client certificate, OIDC token or managed identity must be specified")
+}
+
+// leafCertificate - Returns the certificate of the private key, from a list that may include its chain
+func leafCertificate(certs []*x509.Certificate, key *rsa.PrivateKey) *x509.Certificate {
+	for _, cert := range certs {
+		if publicKey, ok := cert.PublicKey.(*rsa.PublicKey); ok && publicKey.Equal(&key.PublicKey) {
+			return cert
+		}
+	}
+	return nil
+}
+
+// clientSecretCredential - Service principal authentication with a client secret
+type clientSecretCredential struct {
+	tokenEndpoint string
+	scope         string
+	clientId      string
+	clientSecret  string
+}
+
+func (cred *clientSecretCredential) getToken(ctx context.Context, c *Client) (*accessToken, error) {
+	form := url.Values{}
+	form.Set("grant_type", "client_credentials")
+	form.Set("client_id", cred.clientId)
+	form.Set("client_secret", cred.clientSecret)
+	form.Set("scope", cred.scope)
+
+	return c.requestEntraToken(ctx, cred.tokenEndpoint, form)
+}
+
+// clientCertificateCredential - Service principal authentication with a client certificate
+type clientCertificateCredential struct {
+	tokenEndpoint string
+	scope         string
+	clientId      string
+	certificate   *x509.Certificate
+	privateKey    *rsa.PrivateKey
+}
+
+func (cred *clientCertificateCredential) getToken(ctx context.Context, c *Client) (*accessToken, error) {
+	assertion, err := cred.buildAssertion()
+	if err != nil {
+		return nil, err
+	}
+
+	form := url.Values{}
+	form.Set("grant_type", "client_credentials")
+	form.Set("client_id", cred.clientId)
+	form.Set("client_assertion_type", "urn:ietf:params:oauth:client-assertion-type:jwt-bearer")
+	form.Set("client_assertion", assertion)
+	form.Set("scope", cred.scope)
+
+	return c.requestEntraToken(ctx, cred.tokenEndpoint, form)
+}
+
+// buildAssertion - Construct a signed JWT assertion for the certificate, as required by Microsoft Entra ID
+func (cred *clientCertificateCredential) buildAssertion() (string, error) {
+	thumbprint := sha1.Sum(cred.certificate.Raw)
+	header, err := json.Marshal(map[string]string{
+		"alg": "RS256",
+		"typ": "JWT",
+		"x5t": base64.RawURLEncoding.EncodeToString(thumbprint[:]),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	jti := make([]byte, 16)
+	if _, err := rand.Read(jti); err != nil {
+		return "", err
+	}
+	now := time.Now()
+	claims, err := json.Marshal(map[string]any{
+		"aud": cred.tokenEndpoint,
+		"iss": cred.clientId,
+		"sub": cred.clientId,
+		"jti": hex.EncodeToString(jti),
+		"nbf": now.Unix(),
+		"exp": now.Add(10 * time.Minute).Unix(),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	unsigned := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(claims)
+	hashed := sha256.Sum256([]byte(unsigned))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, cred.privateKey, crypto.SHA256, hashed[:])
+	if err != nil {
+		return "", err
+	}
+
+	return unsigned + "." + base64.RawURLEncoding.EncodeToString(signature), nil
+}
+
+// oidcCredential - Workload identity federation, exchanging an OIDC token issued by a trusted identity provider
+type oidcCredential struct {
+	tokenEndpoint string
+	scope         string
+	clientId      string
+	token         string
+	tokenFilePath string
+}
+
+func (cred *oidcCredential) getToken(ctx context.Context, c *Client) (*accessToken, error) {
+	//the token file is read on every request, since the identity provider can rotate it
+	assertion := cred.token
+	if cred.tokenFilePath != "" {
+		content, err := os.ReadFile(cred.tokenFilePath)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read the OIDC token file: %w", err)
+		}
+		assertion = strings.TrimSpace(string(content))
+	}
+
+	form := url.Values{}
+	form.Set("grant_type", "client_credentials")
+	form.Set("client_id", cred.clientId)
+	form.Set("client_assertion_type", "urn:ietf:params:oauth:client-assertion-type:jwt-bearer")
+	form.Set("client_assertion", assertion)
+	form.Set("scope", cred.scope)
+
+	return c.requestEntraToken(ctx, cred.tokenEndpoint, form)
+}
+
+// managedIdentityCredential - Azure managed identity, system assigned or user assigned if a client id is supplied
+type managedIdentityCredential struct {
+	endpoint string
+	resource string
+	clientId string
+}
+
+func (cred *managedIdentityCredential) getToken(ctx context.Context, c *Client) (*accessToken, error) {
+	//App Service and Functions expose their own identity endpoint, otherwise IMDS is used
+	endpoint := cred.endpoint
+	apiVersion := "2018-02-01"
+	identityHeader := ""
+	if endpoint == "" {
+		if os.Getenv("IDENTITY_ENDPOINT") != "" && os.Getenv("IDENTITY_HEADER") != "" {
+			endpoint = os.Getenv("IDENTITY_ENDPOINT")
+			apiVersion = "2019-08-01"
+			identityHeader = os.Getenv("IDENTITY_HEADER")
+		} else {
+			endpoint = DefaultMsiEndpoint
+		}
+	}
+
+	query := url.Values{}
+	query.Set("api-version", apiVersion)
+	query.Set("resource", cred.resource)
+	if cred.clientId != "" {
+		query.Set("client_id", cred.clientId)
+	}
+
+	//prepare request
+	req, err := http.NewRequestWithContext(ctx, "GET", endpoint+"?"+query.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Metadata", "true")
+	if identityHeader != "" {
+		req.Header.Set("X-IDENTITY-HEADER", identityHeader)
+	}
+
+	return c.doTokenRequest(req)
+}
+
+// requestEntraToken - Perform a token request against the Microsoft Entra ID token endpoint
+func (c *Client) requestEntraToken(ctx context.Context, tokenEndpoint string, form url.Values) (*accessToken, error) {
+	//prepare request
+	req, err := http.NewRequestWithContext(ctx, "POST", tokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	return c.doTokenRequest(req)
+}
+
+// doTokenRequest - Perform a token request and map the response
+func (c *Client) doTokenRequest(req *http.Request) (*accessToken, error) {
+	//perform request
+	req.Header.Add("Accept", "application/json")
+	res, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	//process response
+	if res.StatusCode != http.StatusOK {
+		var failure entraErrorResponse
+		if err := json.NewDecoder(res.Body).Decode(&failure); err != nil || failure.Error == "" {
+			return nil, fmt.Errorf("unable to acquire access token, status: %d", res.StatusCode)
+		}
+		return nil, fmt.Errorf("unable to acquire access token, status: %d, error: %s, description: %s", res.StatusCode, failure.Error, failure.ErrorDescription)
+	}
+	var response entraTokenResponse
+	err = json.NewDecoder(res.Body).Decode(&response)
+	if err != nil {
+		return nil, err
+	}
+	if response.AccessToken == "" {
+		return nil, errors.New("unable to acquire access token, the response does not include any token")
+	}
+
+	//managed identity endpoints returns the expiration as unix time, Entra ID as lifetime in seconds
+	token := accessToken{
+		Token:     response.AccessToken,
+		ExpiresOn: time.Now().Add(time.Hour),
+	}
+	if seconds, err := strconv.ParseInt(response.ExpiresOn.String(), 10, 64); err == nil {
+		token.ExpiresOn = time.Unix(seconds, 0)
+	} else if seconds, err := strconv.ParseInt(response.ExpiresIn.String(), 10, 64); err == nil {
+		token.ExpiresOn = time.Now().Add(time.Duration(seconds) * time.Second)
+	}
+
+	return &token, nil
+}
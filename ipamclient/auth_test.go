@@ -0,0 +1,299 @@
+package azureipamclient
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+const (
+	testTenantId    = "22222222-2222-2222-2222-222222222222"
+	testClientId    = "33333333-3333-3333-3333-333333333333"
+	testEngineAppId = "44444444-4444-4444-4444-444444444444"
+)
+
+// newTokenServer starts a stand-in Microsoft Entra ID token endpoint, that returns an error
+// when the request is rejected by the check function.
+func newTokenServer(t *testing.T, check func(r *http.Request) string) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/"+testTenantId+"/oauth2/v2.0/token" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if err := r.ParseForm(); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if r.PostForm.Get("grant_type") != "client_credentials" ||
+			r.PostForm.Get("client_id") != testClientId ||
+			r.PostForm.Get("scope") != "api://"+testEngineAppId+"/.default" {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"error":"invalid_request","error_description":"unexpected token request"}`))
+			return
+		}
+		if reason := check(r); reason != "" {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"error":"invalid_client","error_description":"` + reason + `"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"token_type":"Bearer","expires_in":3599,"access_token":"mockedToken"}`))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+// newTestCertificate generates a self-signed certificate with its RSA key.
+func newTestCertificate(t *testing.T, name string, parent *x509.Certificate, parentKey *rsa.PrivateKey) (*x509.Certificate, *rsa.PrivateKey) {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("unable to generate the key: %v", err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: name},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  parent == nil,
+		BasicConstraintsValid: true,
+	}
+	if parent == nil {
+		parent, parentKey = template, key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, parentKey)
+	if err != nil {
+		t.Fatalf("unable to create the certificate: %v", err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatalf("unable to parse the certificate: %v", err)
+	}
+	return cert, key
+}
+
+// writePem writes the certificates and the key to a PEM file in a temporary folder.
+func writePem(t *testing.T, certs []*x509.Certificate, key *rsa.PrivateKey) string {
+	t.Helper()
+	var content []byte
+	for _, cert := range certs {
+		content = append(content, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw})...)
+	}
+	if key != nil {
+		der, err := x509.MarshalPKCS8PrivateKey(key)
+		if err != nil {
+			t.Fatalf("unable to marshal the key: %v", err)
+		}
+		content = append(content, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})...)
+	}
+	path := filepath.Join(t.TempDir(), "client.pem")
+	if err := os.WriteFile(path, content, 0600); err != nil {
+		t.Fatalf("unable to write the certificate: %v", err)
+	}
+	return path
+}
+
+// verifyAssertion checks that the JWT assertion is signed with the certificate key and references its thumbprint.
+func verifyAssertion(assertion string, cert *x509.Certificate) string {
+	parts := strings.Split(assertion, ".")
+	if len(parts) != 3 {
+		return "the assertion is not a signed JWT"
+	}
+	headerJson, _ := base64.RawURLEncoding.DecodeString(parts[0])
+	var header map[string]string
+	if err := json.Unmarshal(headerJson, &header); err != nil {
+		return "invalid assertion header"
+	}
+	thumbprint := sha1.Sum(cert.Raw)
+	if header["x5t"] != base64.RawURLEncoding.EncodeToString(thumbprint[:]) {
+		return "the assertion thumbprint doesn't match the certificate"
+	}
+	signature, _ := base64.RawURLEncoding.DecodeString(parts[2])
+	hashed := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	if err := rsa.VerifyPKCS1v15(cert.PublicKey.(*rsa.PublicKey), crypto.SHA256, hashed[:], signature); err != nil {
+		return "invalid assertion signature"
+	}
+	return ""
+}
+
+func TestClientSecretCredential(t *testing.T) {
+	server := newTokenServer(t, func(r *http.Request) string {
+		if r.PostForm.Get("client_secret") != "dummySecretForTesting" {
+			return "invalid secret"
+		}
+		return ""
+	})
+	client, err := NewClient(&server.URL, nil, &AuthConfig{
+		EngineAppId:   testEngineAppId,
+		TenantId:      testTenantId,
+		ClientId:      testClientId,
+		ClientSecret:  "dummySecretForTesting",
+		AuthorityHost: server.URL,
+	}, false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	token, err := client.TokenSource.Token(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if token != "mockedToken" {
+		t.Errorf("expected mockedToken, got %s", token)
+	}
+	cached := client.TokenSource.(*refreshingTokenSource).cached
+	if expiresIn := time.Until(cached.ExpiresOn); expiresIn < 59*time.Minute || expiresIn > time.Hour {
+		t.Errorf("expected the token to expire in 3599 seconds, expires in %s", expiresIn)
+	}
+}
+
+func TestClientCertificateCredentialWithChain(t *testing.T) {
+	//the chain is stored before the leaf certificate, that must be found by its key
+	ca, caKey := newTestCertificate(t, "ca", nil, nil)
+	leaf, leafKey := newTestCertificate(t, "client", ca, caKey)
+	path := writePem(t, []*x509.Certificate{ca, leaf}, leafKey)
+
+	server := newTokenServer(t, func(r *http.Request) string {
+		if r.PostForm.Get("client_assertion_type") != "urn:ietf:params:oauth:client-assertion-type:jwt-bearer" {
+			return "missing assertion type"
+		}
+		return verifyAssertion(r.PostForm.Get("client_assertion"), leaf)
+	})
+	client, err := NewClient(&server.URL, nil, &AuthConfig{
+		EngineAppId:           testEngineAppId,
+		TenantId:              testTenantId,
+		ClientId:              testClientId,
+		ClientCertificatePath: path,
+		AuthorityHost:         server.URL,
+	}, false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	token, err := client.TokenSource.Token(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if token != "mockedToken" {
+		t.Errorf("expected mockedToken, got %s", token)
+	}
+}
+
+func TestClientCertificateCredentialWithoutMatchingCertificate(t *testing.T) {
+	cert, _ := newTestCertificate(t, "other", nil, nil)
+	_, key := newTestCertificate(t, "client", nil, nil)
+	path := writePem(t, []*x509.Certificate{cert}, key)
+
+	_, err := NewClient(nil, nil, &AuthConfig{
+		EngineAppId:           testEngineAppId,
+		TenantId:              testTenantId,
+		ClientId:              testClientId,
+		ClientCertificatePath: path,
+	}, false)
+	if err == nil || !strings.Contains(err.Error(), "doesn't contain the certificate of the private key") {
+		t.Errorf("expected a missing certificate error, got %v", err)
+	}
+}
+
+func TestOidcCredentialReadsTokenFile(t *testing.T) {
+	//the identity provider rotates the token file, so it must be read on every token request
+	path := filepath.Join(t.TempDir(), "token")
+	expected := "first-oidc-token"
+	server := newTokenServer(t, func(r *http.Request) string {
+		if r.PostForm.Get("client_assertion") != expected {
+			return "unexpected assertion " + r.PostForm.Get("client_assertion")
+		}
+		return ""
+	})
+	client, err := NewClient(&server.URL, nil, &AuthConfig{
+		EngineAppId:       testEngineAppId,
+		TenantId:          testTenantId,
+		ClientId:          testClientId,
+		OidcTokenFilePath: path,
+		AuthorityHost:     server.URL,
+	}, false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for _, token := range []string{"first-oidc-token", "second-oidc-token"} {
+		expected = token
+		if err := os.WriteFile(path, []byte(token+"\n"), 0600); err != nil {
+			t.Fatalf("unable to write the token file: %v", err)
+		}
+		client.TokenSource.Invalidate()
+		if _, err := client.TokenSource.Token(context.Background()); err != nil {
+			t.Errorf("unexpected error with %s: %v", token, err)
+		}
+	}
+}
+
+func TestManagedIdentityCredential(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Metadata") != "true" ||
+			r.URL.Query().Get("resource") != "api://"+testEngineAppId ||
+			r.URL.Query().Get("client_id") != testClientId {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		_, _ = w.Write([]byte(`{"token_type":"Bearer","expires_on":"4102444800","access_token":"mockedManagedIdentityToken"}`))
+	}))
+	defer server.Close()
+	client, err := NewClient(&server.URL, nil, &AuthConfig{
+		EngineAppId: testEngineAppId,
+		ClientId:    testClientId,
+		UseMsi:      true,
+		MsiEndpoint: server.URL,
+	}, false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	token, err := client.TokenSource.Token(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if token != "mockedManagedIdentityToken" {
+		t.Errorf("expected mockedManagedIdentityToken, got %s", token)
+	}
+	if expiresOn := client.TokenSource.(*refreshingTokenSource).cached.ExpiresOn; !expiresOn.Equal(time.Unix(4102444800, 0)) {
+		t.Errorf("expected the token to expire at the unix time 4102444800, expires at %s", expiresOn)
+	}
+}
+
+func TestTokenRequestError(t *testing.T) {
+	server := newTokenServer(t, func(r *http.Request) string {
+		return "invalid secret"
+	})
+	client, err := NewClient(&server.URL, nil, &AuthConfig{
+		EngineAppId:   testEngineAppId,
+		TenantId:      testTenantId,
+		ClientId:      testClientId,
+		ClientSecret:  "wrongSecret",
+		AuthorityHost: server.URL,
+	}, false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	_, err = client.TokenSource.Token(context.Background())
+	if err == nil || !strings.Contains(err.Error(), "invalid_client") || !strings.Contains(err.Error(), "invalid secret") {
+		t.Errorf("expected the Entra ID error to be returned, got %v", err)
+	}
+}
@@ -0,0 +1,74 @@
+package azureipamclient
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// GetAzureVnets - Returns the list of the Azure virtual networks discovered by the IPAM engine, with their utilization.
+func (c *Client) GetAzureVnets(ctx context.Context) (*[]AzureVnet, error) {
+	//prepare request
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/api/azure/vnet", c.HostURL), nil)
+	if err != nil {
+		return nil, err
+	}
+	response, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	//process response
+	var vnets []AzureVnet
+	err = json.Unmarshal(response, &vnets)
+	if err != nil {
+		return nil, err
+	}
+
+	return &vnets, nil
+}
+
+// GetAzureSubnets - Returns the list of the Azure subnets discovered by the IPAM engine, with their utilization.
+func (c *Client) GetAzureSubnets(ctx context.Context) (*[]AzureSubnet, error) {
+	//prepare request
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/api/azure/subnet", c.HostURL), nil)
+	if err != nil {
+		return nil, err
+	}
+	response, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	//process response
+	var subnets []AzureSubnet
+	err = json.Unmarshal(response, &subnets)
+	if err != nil {
+		return nil, err
+	}
+
+	return &subnets, nil
+}
+
+// GetAzureEndpoints - Returns the list of the Azure private endpoints and network interfaces discovered by the IPAM engine.
+func (c *Client) GetAzureEndpoints(ctx context.Context) (*[]AzureEndpoint, error) {
+	//prepare request
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/api/azure/endpoint", c.HostURL), nil)
+	if err != nil {
+		return nil, err
+	}
+	response, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	//process response
+	var endpoints []AzureEndpoint
+	err = json.Unmarshal(response, &endpoints)
+	if err != nil {
+		return nil, err
+	}
+
+	return &endpoints, nil
+}
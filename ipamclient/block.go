@@ -1,6 +1,7 @@
 package azureipamclient
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -20,9 +21,9 @@ type blockUpdateRequest struct {
 }
 
 // GetBlocks - Returns a list of all Blocks within a specific Space.
-func (c *Client) GetBlocks(space string, expand bool, appendUtilization bool) (*[]BlockInfo, error) {
+func (c *Client) GetBlocks(ctx context.Context, space string, expand bool, appendUtilization bool) (*[]BlockInfo, error) {
 	//prepare request
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/api/spaces/%s/blocks?expand=%t&utilization=%t", c.HostURL, space, expand, appendUtilization), nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/api/spaces/%s/blocks?expand=%t&utilization=%t", c.HostURL, space, expand, appendUtilization), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -41,9 +42,9 @@ func (c *Client) GetBlocks(space string, expand bool, appendUtilization bool) (*
 	return &blocksInfo, nil
 }
 
-func (c *Client) GetBlockInfo(space string, name string, expand bool, appendUtilization bool) (*BlockInfo, error) {
+func (c *Client) GetBlockInfo(ctx context.Context, space string, name string, expand bool, appendUtilization bool) (*BlockInfo, error) {
 	//prepare request
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/api/spaces/%s/blocks/%s?expand=%t&utilization=%t", c.HostURL, space, name, expand, appendUtilization), nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/api/spaces/%s/blocks/%s?expand=%t&utilization=%t", c.HostURL, space, name, expand, appendUtilization), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -63,10 +64,10 @@ func (c *Client) GetBlockInfo(space string, name string, expand bool, appendUtil
 }
 
 // GetBlock - Returns a specifc block by name
-func (c *Client) GetBlock(space string, name string, expand bool, appendUtilization bool) (*Block, error) {
+func (c *Client) GetBlock(ctx context.Context, space string, name string, expand bool, appendUtilization bool) (*Block, error) {
 
 	//prepare request
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/api/spaces/%s/blocks/%s?expand=%t&utilization=%t", c.HostURL, space, name, expand, appendUtilization), nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/api/spaces/%s/blocks/%s?expand=%t&utilization=%t", c.HostURL, space, name, expand, appendUtilization), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -88,7 +89,7 @@ func (c *Client) GetBlock(space string, name string, expand bool, appendUtilizat
 }
 
 // CreateBlock - Create new block
-func (c *Client) CreateBlock(space string, name string, cidr string) (*Block, error) {
+func (c *Client) CreateBlock(ctx context.Context, space string, name string, cidr string) (*Block, error) {
 
 	//construct body
 	request := &blockRequest{
@@ -101,7 +102,7 @@ func (c *Client) CreateBlock(space string, name string, cidr string) (*Block, er
 	}
 
 	//prepare request
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/api/spaces/%s/blocks", c.HostURL, space), strings.NewReader(string(rb)))
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/api/spaces/%s/blocks", c.HostURL, space), strings.NewReader(string(rb)))
 	if err != nil {
 		return nil, err
 	}
@@ -122,7 +123,7 @@ func (c *Client) CreateBlock(space string, name string, cidr string) (*Block, er
 	return &block, nil
 }
 
-func (c *Client) UpdateBlock(space string, name string, newName *string, newCidr *string) (*Block, error) {
+func (c *Client) UpdateBlock(ctx context.Context, space string, name string, newName *string, newCidr *string) (*Block, error) {
 
 	//construct body
 	var request = []blockUpdateRequest{}
@@ -147,17 +148,17 @@ func (c *Client) UpdateBlock(space string, name string, newName *string, newCidr
 	}
 
 	//prepare request
-	req, err := http.NewRequest("PATCH", fmt.Sprintf("%s/api/spaces/%s/blocks/%s", c.HostURL, space, name), strings.NewReader(string(rb)))
+	req, err := http.NewRequestWithContext(ctx, "PATCH", fmt.Sprintf("%s/api/spaces/%s/blocks/%s", c.HostURL, space, name), strings.NewReader(string(rb)))
 	if err != nil {
 		return nil, err
 	}
-	response, err := c.doRequest(req)
+	_, err = c.doRequest(req)
 	if err != nil {
-		return nil, errors.New(string(response) + ";inner error: " + err.Error())
+		return nil, err
 	}
 
 	//read and return the updated block
-	retVal, err := c.GetBlock(space, *newName, false, false)
+	retVal, err := c.GetBlock(ctx, space, *newName, false, false)
 	if err != nil {
 		return nil, err
 	}
@@ -165,10 +166,10 @@ func (c *Client) UpdateBlock(space string, name string, newName *string, newCidr
 }
 
 // DeleteBlock- Deletes a block
-func (c *Client) DeleteBlock(space string, name string, force bool) error {
+func (c *Client) DeleteBlock(ctx context.Context, space string, name string, force bool) error {
 
 	//prepare request
-	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/api/spaces/%s/blocks/%s?force=%t", c.HostURL, space, name, force), nil)
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/api/spaces/%s/blocks/%s?force=%t", c.HostURL, space, name, force), nil)
 	if err != nil {
 		return err
 	}
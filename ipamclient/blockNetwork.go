@@ -1,6 +1,7 @@
 package azureipamclient
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -8,6 +9,18 @@ import (
 	"strings"
 )
 
+// Block network types, discriminated by the Azure resource type of the network
+const (
+	BlockNetworkTypeVnet = "vnet"
+	BlockNetworkTypeVhub = "vhub"
+)
+
+// azure resource types of the networks that can be associated to a block
+var blockNetworkResourceTypes = map[string]string{
+	"microsoft.network/virtualnetworks": BlockNetworkTypeVnet,
+	"microsoft.network/virtualhubs":     BlockNetworkTypeVhub,
+}
+
 // internal Models
 type blockNetworkRequest struct {
 	Id     string `json:"id"`
@@ -15,9 +28,9 @@ type blockNetworkRequest struct {
 }
 
 //GetBlockNetworksAvailables - Return a list of the Azure resource ids virtual networks availables to be associated to the space and block specified
-func (c *Client) GetBlockNetworksAvailables(space string, block string) (*[]string, error) {
+func (c *Client) GetBlockNetworksAvailables(ctx context.Context, space string, block string) (*[]string, error) {
 	//prepare request
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/api/spaces/%s/blocks/%s/available", c.HostURL, space, block), nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/api/spaces/%s/blocks/%s/available", c.HostURL, space, block), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -38,9 +51,9 @@ func (c *Client) GetBlockNetworksAvailables(space string, block string) (*[]stri
 
 
 // GetBlockNetworksInfo - Returns a list of all Block Networks within a specific Space and Block.
-func (c *Client) GetBlockNetworksInfo(space string, block string, expand bool) (*[]BlockNetworkInfo, error) {
+func (c *Client) GetBlockNetworksInfo(ctx context.Context, space string, block string, expand bool) (*[]BlockNetworkInfo, error) {
 	//prepare request
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/api/spaces/%s/blocks/%s/networks?expand=%t", c.HostURL, space, block, expand), nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/api/spaces/%s/blocks/%s/networks?expand=%t", c.HostURL, space, block, expand), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -55,15 +68,23 @@ func (c *Client) GetBlockNetworksInfo(space string, block string, expand bool) (
 	if err != nil {
 		return nil, err
 	}
+	//add attributes not included in response
+	for i := range externalsInfo {
+		externalsInfo[i].Type = GetBlockNetworkType(externalsInfo[i].Id)
+		//the address prefix of the hubs is returned as its only prefix
+		if externalsInfo[i].Type == BlockNetworkTypeVhub && externalsInfo[i].AddressPrefix == nil && len(externalsInfo[i].Prefixes) > 0 {
+			externalsInfo[i].AddressPrefix = &externalsInfo[i].Prefixes[0]
+		}
+	}
 
 	return &externalsInfo, nil
 }
 
 // GetBlockNetworkInfo - Returns a specifc block network by space, block and id
-func (c *Client) GetBlockNetworkInfo(space string, block string, id string, expand bool) (*BlockNetworkInfo, error) {
+func (c *Client) GetBlockNetworkInfo(ctx context.Context, space string, block string, id string, expand bool) (*BlockNetworkInfo, error) {
 
 	//Read all external networks in a space and block
-	networks, err := c.GetBlockNetworksInfo(space, block, expand)
+	networks, err := c.GetBlockNetworksInfo(ctx, space, block, expand)
 	if err != nil {
 		return nil, err
 	}
@@ -75,14 +96,14 @@ func (c *Client) GetBlockNetworkInfo(space string, block string, id string, expa
 		}
 	}
 	if networkInfo == nil {
-		return nil, errors.New("invalid block network id")
+		return nil, fmt.Errorf("block network %s %w", id, ErrNotFound)
 	}
 
 	return networkInfo, nil
 }
 
 // CreateBlockNetwork - Create new block network within a specific Space and Block.
-func (c *Client) CreateBlockNetwork(space string, block string, id string) (*BlockNetworkInfo, error) {
+func (c *Client) CreateBlockNetwork(ctx context.Context, space string, block string, id string) (*BlockNetworkInfo, error) {
 
 	//construct body
 	request := &blockNetworkRequest{
@@ -95,7 +116,7 @@ func (c *Client) CreateBlockNetwork(space string, block string, id string) (*Blo
 	}
 
 	//prepare request
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/api/spaces/%s/blocks/%s/networks", c.HostURL, space, block), strings.NewReader(string(rb)))
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/api/spaces/%s/blocks/%s/networks", c.HostURL, space, block), strings.NewReader(string(rb)))
 	if err != nil {
 		return nil, err
 	}
@@ -112,7 +133,7 @@ func (c *Client) CreateBlockNetwork(space string, block string, id string) (*Blo
 	}
 
 	//Create return object
-	ret,err := c.GetBlockNetworkInfo(space, block, id, true)
+	ret,err := c.GetBlockNetworkInfo(ctx, space, block, id, true)
 	if err != nil {
 		return nil, err
 	}
@@ -121,7 +142,7 @@ func (c *Client) CreateBlockNetwork(space string, block string, id string) (*Blo
 }
 
  // DeleteBlockNetwork- Deletes a block network within a specific Space and Block.
-func (c *Client) DeleteBlockNetwork(space string, block string, id string) error {
+func (c *Client) DeleteBlockNetwork(ctx context.Context, space string, block string, id string) error {
 	
 	//construct body
 	request := []string {
@@ -133,7 +154,7 @@ func (c *Client) DeleteBlockNetwork(space string, block string, id string) error
 	}
 
 	//prepare request
-	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/api/spaces/%s/blocks/%s/networks", c.HostURL, space, block), strings.NewReader(string(rb)))
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/api/spaces/%s/blocks/%s/networks", c.HostURL, space, block), strings.NewReader(string(rb)))
 	if err != nil {
 		return err
 	}
@@ -149,3 +170,41 @@ func (c *Client) DeleteBlockNetwork(space string, block string, id string) error
 
 	return nil
 }
+
+// ReplaceBlockNetworks - Replaces the full list of networks associated to a specific Space and Block with the ids specified, and returns the resulting block networks.
+func (c *Client) ReplaceBlockNetworks(ctx context.Context, space string, block string, ids []string) (*[]BlockNetworkInfo, error) {
+
+	//construct body, an empty list must be sent as [] and not as null
+	if ids == nil {
+		ids = []string{}
+	}
+	rb, err := json.Marshal(ids)
+	if err != nil {
+		return nil, err
+	}
+
+	//prepare request
+	req, err := http.NewRequestWithContext(ctx, "PUT", fmt.Sprintf("%s/api/spaces/%s/blocks/%s/networks", c.HostURL, space, block), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+	_, err = c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	//read the resulting block networks
+	return c.GetBlockNetworksInfo(ctx, space, block, true)
+}
+
+// GetBlockNetworkType - Returns the type of the network, vnet or vhub, from its Azure resource id, or empty if the resource type is not supported.
+func GetBlockNetworkType(id string) string {
+	//the resource type is the two segments previous to the resource name, e.g. .../providers/Microsoft.Network/virtualHubs/hub-01
+	segments := strings.Split(strings.Trim(id, "/"), "/")
+	if len(segments) < 3 {
+		return ""
+	}
+	resourceType := strings.ToLower(segments[len(segments)-3] + "/" + segments[len(segments)-2])
+
+	return blockNetworkResourceTypes[resourceType]
+}
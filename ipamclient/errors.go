@@ -0,0 +1,101 @@
+package azureipamclient
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+)
+
+// ErrNotFound - The requested object does not exist, detected by the client when it is searched in a collection
+var ErrNotFound = errors.New("not found")
+
+// invalidNameMessage - Message returned by the engine when the space, block, external network or reservation in the url does not exist
+var invalidNameMessage = regexp.MustCompile(`(?i)^invalid [a-z ]*(name|id)\.?$`)
+
+// APIError - Error returned by the IPAM API when a request is not successful
+type APIError struct {
+	StatusCode int
+	Method     string
+	URL        string
+	// Message is the error message returned by the IPAM engine, if any
+	Message string
+	// Body is the raw response body
+	Body string
+}
+
+// internal Models
+type apiErrorResponse struct {
+	Error  string `json:"error"`
+	Detail any    `json:"detail"`
+}
+
+// newAPIError - Construct the error from the request and the response, decoding the IPAM error message
+func newAPIError(req *http.Request, res *http.Response, body []byte) *APIError {
+	apiErr := APIError{
+		StatusCode: res.StatusCode,
+		Method:     req.Method,
+		URL:        req.URL.String(),
+		Body:       string(body),
+	}
+
+	//the engine returns the message as {"error": "..."}, but validation errors are returned as {"detail": ...}
+	var response apiErrorResponse
+	if err := json.Unmarshal(body, &response); err == nil {
+		if response.Error != "" {
+			apiErr.Message = response.Error
+		} else if detail, ok := response.Detail.(string); ok {
+			apiErr.Message = detail
+		} else if response.Detail != nil {
+			if detail, err := json.Marshal(response.Detail); err == nil {
+				apiErr.Message = string(detail)
+			}
+		}
+	}
+
+	return &apiErr
+}
+
+func (e *APIError) Error() string {
+	if e.Message != "" {
+		return fmt.Sprintf("%s %s failed with status: %d, error: %s", e.Method, e.URL, e.StatusCode, e.Message)
+	}
+	return fmt.Sprintf("%s %s failed with status: %d, body: %s", e.Method, e.URL, e.StatusCode, e.Body)
+}
+
+// IsNotFound - The error indicates that the requested object does not exist
+func IsNotFound(err error) bool {
+	if errors.Is(err, ErrNotFound) || hasStatusCode(err, http.StatusNotFound) {
+		return true
+	}
+
+	//the engine reports missing spaces, blocks and external networks as a bad request with an invalid name message
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusBadRequest && invalidNameMessage.MatchString(apiErr.Message)
+}
+
+// IsConflict - The error indicates that the request conflicts with the current state of the object
+func IsConflict(err error) bool {
+	return hasStatusCode(err, http.StatusConflict)
+}
+
+// IsUnauthorized - The error indicates that the access token was rejected
+func IsUnauthorized(err error) bool {
+	return hasStatusCode(err, http.StatusUnauthorized)
+}
+
+// IsForbidden - The error indicates that the identity has not enough permissions in the IPAM solution
+func IsForbidden(err error) bool {
+	return hasStatusCode(err, http.StatusForbidden)
+}
+
+// IsBadRequest - The error indicates that the request has been rejected by the IPAM engine validations
+func IsBadRequest(err error) bool {
+	return hasStatusCode(err, http.StatusBadRequest)
+}
+
+func hasStatusCode(err error, statusCode int) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == statusCode
+}
@@ -0,0 +1,93 @@
+package azureipamclient
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// GetExclusions - Returns the list of the subscription ids excluded from the IPAM discovery.
+func (c *Client) GetExclusions(ctx context.Context) (*[]string, error) {
+	//prepare request
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/api/admin/exclusions", c.HostURL), nil)
+	if err != nil {
+		return nil, err
+	}
+	response, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	//process response, a null or empty response means that there are no exclusions
+	exclusions := []string{}
+	if len(strings.TrimSpace(string(response))) == 0 {
+		return &exclusions, nil
+	}
+	err = json.Unmarshal(response, &exclusions)
+	if err != nil {
+		return nil, err
+	}
+	if exclusions == nil {
+		exclusions = []string{}
+	}
+
+	return &exclusions, nil
+}
+
+// AddExclusions - Adds the subscription ids to the list of exclusions, keeping the existing ones.
+func (c *Client) AddExclusions(ctx context.Context, subscriptionIds []string) (*[]string, error) {
+	return c.sendExclusions(ctx, "POST", subscriptionIds)
+}
+
+// ReplaceExclusions - Replaces the full list of exclusions with the subscription ids specified.
+func (c *Client) ReplaceExclusions(ctx context.Context, subscriptionIds []string) (*[]string, error) {
+	return c.sendExclusions(ctx, "PUT", subscriptionIds)
+}
+
+// DeleteExclusion - Removes a subscription id from the list of exclusions.
+func (c *Client) DeleteExclusion(ctx context.Context, subscriptionId string) error {
+	//prepare request
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/api/admin/exclusions/%s", c.HostURL, subscriptionId), nil)
+	if err != nil {
+		return err
+	}
+	response, err := c.doRequest(req)
+	if err != nil {
+		return err
+	}
+
+	//process response
+	if string(response) != "" {
+		return errors.New(string(response))
+	}
+
+	return nil
+}
+
+// sendExclusions - Sends the list of subscription ids to the exclusions endpoint, and returns the resulting list of exclusions.
+func (c *Client) sendExclusions(ctx context.Context, method string, subscriptionIds []string) (*[]string, error) {
+	//construct body, an empty list must be sent as [] and not as null
+	if subscriptionIds == nil {
+		subscriptionIds = []string{}
+	}
+	rb, err := json.Marshal(subscriptionIds)
+	if err != nil {
+		return nil, err
+	}
+
+	//prepare request
+	req, err := http.NewRequestWithContext(ctx, method, fmt.Sprintf("%s/api/admin/exclusions", c.HostURL), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+	_, err = c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	//read the resulting exclusions
+	return c.GetExclusions(ctx)
+}
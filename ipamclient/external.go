@@ -1,6 +1,7 @@
 package azureipamclient
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -11,15 +12,16 @@ import (
 
 // internal Models
 type externalRequest struct {
-	Name        string `json:"name"`
-	Description string `json:"desc"`
-	Cidr        string `json:"cidr"`
+	Name        string               `json:"name"`
+	Description string               `json:"desc"`
+	Cidr        string               `json:"cidr"`
+	Subnets     []ExternalSubnetInfo `json:"subnets,omitempty"`
 }
 
 // GetExternalsInfo - Returns a list of all External Network within a specific Space and Block.
-func (c *Client) GetExternalsInfo(space string, block string) (*[]ExternalInfo, error) {
+func (c *Client) GetExternalsInfo(ctx context.Context, space string, block string) (*[]ExternalInfo, error) {
 	//prepare request
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/api/spaces/%s/blocks/%s/externals", c.HostURL, space, block), nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/api/spaces/%s/blocks/%s/externals", c.HostURL, space, block), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -39,10 +41,10 @@ func (c *Client) GetExternalsInfo(space string, block string) (*[]ExternalInfo,
 }
 
 // GetExternal - Returns a specifc external network by space, block and name
-func (c *Client) GetExternal(space string, block string, name string) (*External, error) {
+func (c *Client) GetExternal(ctx context.Context, space string, block string, name string) (*External, error) {
 
 	//prepare request
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/api/spaces/%s/blocks/%s/externals/%s", c.HostURL, space, block, name), nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/api/spaces/%s/blocks/%s/externals/%s", c.HostURL, space, block, name), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -70,10 +72,10 @@ func (c *Client) GetExternal(space string, block string, name string) (*External
 }
 
 // GetExternalInfo - Returns a specifc external network by space, block and name with minimal information
-func (c *Client) GetExternalInfo(space string, block string, name string) (*ExternalInfo, error) {
+func (c *Client) GetExternalInfo(ctx context.Context, space string, block string, name string) (*ExternalInfo, error) {
 
 	//prepare request
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/api/spaces/%s/blocks/%s/externals/%s", c.HostURL, space, block, name), nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/api/spaces/%s/blocks/%s/externals/%s", c.HostURL, space, block, name), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -100,7 +102,7 @@ func (c *Client) GetExternalInfo(space string, block string, name string) (*Exte
 
 
 // CreateExternal - Create new external network within a specific Space and Block.
-func (c *Client) CreateExternal(space string, block string, name string, desc string, cidr string) (*External, error) {
+func (c *Client) CreateExternal(ctx context.Context, space string, block string, name string, desc string, cidr string) (*External, error) {
 
 	//construct body
 	request := &externalRequest{
@@ -114,7 +116,7 @@ func (c *Client) CreateExternal(space string, block string, name string, desc st
 	}
 
 	//prepare request
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/api/spaces/%s/blocks/%s/externals", c.HostURL, space, block), strings.NewReader(string(rb)))
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/api/spaces/%s/blocks/%s/externals", c.HostURL, space, block), strings.NewReader(string(rb)))
 	if err != nil {
 		return nil, err
 	}
@@ -144,10 +146,10 @@ func (c *Client) CreateExternal(space string, block string, name string, desc st
 	return &ret, nil
 }
 
-func (c *Client) UpdateExternal(space string, block string, name string, newName *string, newDescription *string, newCidr *string) (*External, error) {
+func (c *Client) UpdateExternal(ctx context.Context, space string, block string, name string, newName *string, newDescription *string, newCidr *string) (*External, error) {
 
 	//Read all external network collection
-	externals, err := c.GetExternalsInfo(space, block)
+	externals, err := c.GetExternalsInfo(ctx, space, block)
 	if err != nil {
 		return nil, err
 	}
@@ -174,17 +176,17 @@ func (c *Client) UpdateExternal(space string, block string, name string, newName
 	}
 
 	//prepare request
-	req, err := http.NewRequest("PUT", fmt.Sprintf("%s/api/spaces/%s/blocks/%s/externals", c.HostURL, space, block), strings.NewReader(string(rb)))
+	req, err := http.NewRequestWithContext(ctx, "PUT", fmt.Sprintf("%s/api/spaces/%s/blocks/%s/externals", c.HostURL, space, block), strings.NewReader(string(rb)))
 	if err != nil {
 		return nil, err
 	}
-	response, err := c.doRequest(req)
+	_, err = c.doRequest(req)
 	if err != nil {
-		return nil, errors.New(string(response) + ";inner error: " + err.Error())
+		return nil, err
 	}
 
 	//read and return the updated external network
-	retVal, err := c.GetExternal(space, block, *newName)
+	retVal, err := c.GetExternal(ctx, space, block, *newName)
 	if err != nil {
 		return nil, err
 	}
@@ -192,10 +194,10 @@ func (c *Client) UpdateExternal(space string, block string, name string, newName
 }
 
 // DeleteExternal- Deletes a external networl
-func (c *Client) DeleteExternal(space string, block string, name string) error {
+func (c *Client) DeleteExternal(ctx context.Context, space string, block string, name string) error {
 
 	//prepare request
-	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/api/spaces/%s/blocks/%s/externals/%s", c.HostURL, space, block, name), nil)
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/api/spaces/%s/blocks/%s/externals/%s", c.HostURL, space, block, name), nil)
 	if err != nil {
 		return err
 	}
@@ -211,3 +213,48 @@ func (c *Client) DeleteExternal(space string, block string, name string) error {
 
 	return nil
 }
+
+// ReplaceExternals - Replaces the full list of external networks within a specific Space and Block, and returns the resulting external networks.
+// The subnets of the external networks that are kept are sent again, since the engine replaces each external network with the one in the request.
+func (c *Client) ReplaceExternals(ctx context.Context, space string, block string, externals []ExternalInfo) (*[]ExternalInfo, error) {
+
+	//read the current subnets, there are no subnets to keep when the list is emptied
+	subnets := make(map[string][]ExternalSubnetInfo)
+	if len(externals) > 0 {
+		current, err := c.GetExternalsInfo(ctx, space, block)
+		if err != nil {
+			return nil, err
+		}
+		for _, external := range *current {
+			subnets[external.Name] = external.Subnets
+		}
+	}
+
+	//construct body, an empty list must be sent as [] and not as null
+	var request = []externalRequest{}
+	for _, external := range externals {
+		request = append(request, externalRequest{
+			Name:        external.Name,
+			Description: external.Description,
+			Cidr:        external.Cidr,
+			Subnets:     subnets[external.Name],
+		})
+	}
+	rb, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+
+	//prepare request
+	req, err := http.NewRequestWithContext(ctx, "PUT", fmt.Sprintf("%s/api/spaces/%s/blocks/%s/externals", c.HostURL, space, block), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+	_, err = c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	//read the resulting external networks
+	return c.GetExternalsInfo(ctx, space, block)
+}
@@ -0,0 +1,170 @@
+package azureipamclient
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// internal Models
+type externalSubnetRequest struct {
+	Name        string `json:"name"`
+	Description string `json:"desc"`
+	Cidr        string `json:"cidr"`
+}
+type externalSubnetUpdateRequest struct {
+	Op    string `json:"op"`
+	Path  string `json:"path"`
+	Value string `json:"value"`
+}
+
+// GetExternalSubnetsInfo - Returns a list of all the subnets within a specific External Network.
+func (c *Client) GetExternalSubnetsInfo(ctx context.Context, space string, block string, external string) (*[]ExternalSubnetInfo, error) {
+	//prepare request
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/api/spaces/%s/blocks/%s/externals/%s/subnets", c.HostURL, space, block, external), nil)
+	if err != nil {
+		return nil, err
+	}
+	response, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	//process response
+	var subnetsInfo []ExternalSubnetInfo
+	err = json.Unmarshal(response, &subnetsInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	return &subnetsInfo, nil
+}
+
+// GetExternalSubnet - Returns a specifc external network subnet by space, block, external network and name
+func (c *Client) GetExternalSubnet(ctx context.Context, space string, block string, external string, name string) (*ExternalSubnet, error) {
+	//prepare request
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/api/spaces/%s/blocks/%s/externals/%s/subnets/%s", c.HostURL, space, block, external, name), nil)
+	if err != nil {
+		return nil, err
+	}
+	response, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	//process response
+	var readed ExternalSubnetInfo
+	err = json.Unmarshal(response, &readed)
+	if err != nil {
+		return nil, err
+	}
+	//add attributes not included in response
+	ret := ExternalSubnet{
+		Space:       space,
+		Block:       block,
+		External:    external,
+		Name:        readed.Name,
+		Description: readed.Description,
+		Cidr:        readed.Cidr,
+	}
+
+	return &ret, nil
+}
+
+// CreateExternalSubnet - Create new subnet within a specific External Network.
+func (c *Client) CreateExternalSubnet(ctx context.Context, space string, block string, external string, name string, desc string, cidr string) (*ExternalSubnet, error) {
+	//construct body
+	request := &externalSubnetRequest{
+		Name:        name,
+		Description: desc,
+		Cidr:        cidr,
+	}
+	rb, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+
+	//prepare request
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/api/spaces/%s/blocks/%s/externals/%s/subnets", c.HostURL, space, block, external), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+	_, err = c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	//read and return the created subnet
+	return c.GetExternalSubnet(ctx, space, block, external, name)
+}
+
+// UpdateExternalSubnet - Modifies the name, description or cidr of a subnet within a specific External Network.
+func (c *Client) UpdateExternalSubnet(ctx context.Context, space string, block string, external string, name string, newName *string, newDescription *string, newCidr *string) (*ExternalSubnet, error) {
+	//construct body
+	var request = []externalSubnetUpdateRequest{}
+	if newName != nil {
+		request = append(request, externalSubnetUpdateRequest{
+			Op:    "replace",
+			Path:  "/name",
+			Value: *newName,
+		})
+	}
+	if newDescription != nil {
+		request = append(request, externalSubnetUpdateRequest{
+			Op:    "replace",
+			Path:  "/desc",
+			Value: *newDescription,
+		})
+	}
+	if newCidr != nil {
+		request = append(request, externalSubnetUpdateRequest{
+			Op:    "replace",
+			Path:  "/cidr",
+			Value: *newCidr,
+		})
+	}
+
+	rb, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+
+	//prepare request
+	req, err := http.NewRequestWithContext(ctx, "PATCH", fmt.Sprintf("%s/api/spaces/%s/blocks/%s/externals/%s/subnets/%s", c.HostURL, space, block, external, name), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+	_, err = c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	//read and return the updated subnet
+	if newName != nil {
+		name = *newName
+	}
+	return c.GetExternalSubnet(ctx, space, block, external, name)
+}
+
+// DeleteExternalSubnet - Deletes a subnet of an External Network
+func (c *Client) DeleteExternalSubnet(ctx context.Context, space string, block string, external string, name string) error {
+	//prepare request
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/api/spaces/%s/blocks/%s/externals/%s/subnets/%s", c.HostURL, space, block, external, name), nil)
+	if err != nil {
+		return err
+	}
+	response, err := c.doRequest(req)
+	if err != nil {
+		return err
+	}
+
+	//process response
+	if string(response) != "" {
+		return errors.New(string(response))
+	}
+
+	return nil
+}
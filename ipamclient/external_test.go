@@ -0,0 +1,67 @@
+package azureipamclient
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func TestReplaceExternalsKeepsSubnets(t *testing.T) {
+	externals := []ExternalInfo{
+		{Name: "removed", Cidr: "10.83.0.0/24", Subnets: []ExternalSubnetInfo{{Name: "subnet0", Cidr: "10.83.0.0/26"}}},
+		{Name: "kept", Cidr: "10.83.1.0/24", Subnets: []ExternalSubnetInfo{{Name: "subnet1", Cidr: "10.83.1.0/26"}, {Name: "subnet2", Cidr: "10.83.1.64/26"}}},
+	}
+	//the stand-in engine replaces the externals with the request body, so the subnets not sent are lost
+	client, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "PUT" {
+			externals = nil
+			if err := json.NewDecoder(r.Body).Decode(&externals); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+		}
+		_ = json.NewEncoder(w).Encode(externals)
+	})
+
+	replaced, err := client.ReplaceExternals(context.Background(), "au", "AustraliaEast", []ExternalInfo{
+		{Name: "kept", Description: "updated", Cidr: "10.83.1.0/24"},
+		{Name: "new", Cidr: "10.90.0.0/16"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(*replaced) != 2 {
+		t.Fatalf("expected 2 externals, got %v", *replaced)
+	}
+	kept, added := (*replaced)[0], (*replaced)[1]
+	if kept.Description != "updated" || len(kept.Subnets) != 2 || kept.Subnets[0].Name != "subnet1" || kept.Subnets[1].Name != "subnet2" {
+		t.Errorf("expected the subnets of the kept external to survive the update, got %v", kept)
+	}
+	if len(added.Subnets) != 0 {
+		t.Errorf("expected no subnets in the new external, got %v", added.Subnets)
+	}
+}
+
+func TestReplaceExternalsEmptyList(t *testing.T) {
+	//the externals are not read when the list is emptied, and an empty list is sent instead of null
+	var gets int
+	client, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "GET" {
+			gets++
+			_, _ = w.Write([]byte("[]"))
+			return
+		}
+		var body []externalRequest
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil || body == nil {
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	})
+
+	if _, err := client.ReplaceExternals(context.Background(), "au", "AustraliaEast", []ExternalInfo{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if gets != 1 {
+		t.Errorf("expected only the final read of the externals, got %d reads", gets)
+	}
+}
@@ -4,19 +4,42 @@ import (
 	"crypto/tls"
 	"fmt"
 	"io"
+	"math/rand"
 	"net/http"
+	"strconv"
 	"time"
 )
 
+const (
+	// DefaultRequestTimeout - Time limit of each request when no other is configured
+	DefaultRequestTimeout = 30 * time.Second
+	// DefaultMaxRetries - Number of times a failed request is retried when no other is configured
+	DefaultMaxRetries = 3
+	// DefaultRetryMinWait - Initial wait between retries when no other is configured
+	DefaultRetryMinWait = 1 * time.Second
+	// DefaultRetryMaxWait - Maximum wait between retries when no other is configured
+	DefaultRetryMaxWait = 30 * time.Second
+)
+
 // Client -
 type Client struct {
-	HostURL    string
-	HTTPClient *http.Client
-	Token      string
+	HostURL     string
+	HTTPClient  *http.Client
+	TokenSource TokenSource
+
+	// MaxRetries is the number of times a request is retried after a transient error, 0 to disable retries
+	MaxRetries int
+	// RetryMinWait is the wait before the first retry, doubled on each subsequent retry
+	RetryMinWait time.Duration
+	// RetryMaxWait is the maximum wait between retries, also applied to the Retry-After header
+	RetryMaxWait time.Duration
+	// RetryNonIdempotent allows to retry also POST and PATCH requests, that are not retried by default
+	RetryNonIdempotent bool
 }
 
-// NewClient - Construct a new HTTP Client to interact with the APIM REST API
-func NewClient(host, authToken *string, SkipCertificateVerification bool) (*Client, error) {
+// NewClient - Construct a new HTTP Client to interact with the APIM REST API.
+// When no authToken is provided, the access tokens are acquired from Microsoft Entra ID using the auth settings.
+func NewClient(host, authToken *string, auth *AuthConfig, SkipCertificateVerification bool) (*Client, error) {
 	var tr http.RoundTripper
 	if SkipCertificateVerification {
 		tr = &http.Transport{
@@ -26,15 +49,26 @@ func NewClient(host, authToken *string, SkipCertificateVerification bool) (*Clie
 		tr = http.DefaultTransport //Use http.DefaultTransport, needed to allow acceptance tests with [jarcoal/httpmock](https://github.com/jarcoal/httpmock)
 	}
 	c := Client{
-		HTTPClient: &http.Client{Timeout: 10 * time.Second, Transport: tr},
+		HTTPClient:   &http.Client{Timeout: DefaultRequestTimeout, Transport: tr},
+		MaxRetries:   DefaultMaxRetries,
+		RetryMinWait: DefaultRetryMinWait,
+		RetryMaxWait: DefaultRetryMaxWait,
 	}
 
 	// set client values, if provided
 	if host != nil {
 		c.HostURL = *host
 	}
-	if authToken != nil {
-		c.Token = *authToken
+	if authToken != nil && *authToken != "" {
+		c.TokenSource = NewStaticTokenSource(*authToken)
+	} else if auth != nil {
+		credential, err := newTokenCredential(auth)
+		if err != nil {
+			return nil, err
+		}
+		c.TokenSource = &refreshingTokenSource{client: &c, credential: credential}
+	} else {
+		c.TokenSource = NewStaticTokenSource("")
 	}
 
 	return &c, nil
@@ -42,25 +76,150 @@ func NewClient(host, authToken *string, SkipCertificateVerification bool) (*Clie
 
 // doRequest -
 func (c *Client) doRequest(req *http.Request) ([]byte, error) {
+	tokenRenewed := false
+	for attempt := 0; ; attempt++ {
+		//perform request
+		res, body, token, err := c.send(req)
+
+		//the token could have been revoked before its expiration, so renew it and retry once
+		if err == nil && res.StatusCode == http.StatusUnauthorized && !tokenRenewed {
+			tokenRenewed = true
+			c.TokenSource.Invalidate()
+			if renewed, errToken := c.TokenSource.Token(req.Context()); errToken == nil && renewed != token {
+				if req, err = rewindRequest(req); err != nil {
+					return nil, err
+				}
+				attempt--
+				continue
+			}
+		}
+
+		//wait and retry transient errors
+		if attempt < c.MaxRetries && c.isRetryable(req, res, err) {
+			if err := c.waitRetry(req, res, attempt); err != nil {
+				return nil, err
+			}
+			if req, err = rewindRequest(req); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		//write error not StatusOK
+		if res.StatusCode != http.StatusOK && res.StatusCode != http.StatusCreated && res.StatusCode != http.StatusAccepted && res.StatusCode != http.StatusNoContent {
+			return nil, newAPIError(req, res, body)
+		}
+
+		return body, nil
+	}
+}
+
+// send - Authenticate and perform a request, returning the response with the body already read and the token used
+func (c *Client) send(req *http.Request) (*http.Response, []byte, string, error) {
+	//get access token
+	token, err := c.TokenSource.Token(req.Context())
+	if err != nil {
+		return nil, nil, "", &tokenError{err: err}
+	}
+
 	//perform request
-	req.Header.Add("Accept", "application/json")
-	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.Token))
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
 	res, err := c.HTTPClient.Do(req)
 	if err != nil {
-		return nil, err
+		return nil, nil, "", err
 	}
 	defer res.Body.Close()
 
 	//read response body
 	body, err := io.ReadAll(res.Body)
 	if err != nil {
-		return nil, err
+		return nil, nil, "", err
+	}
+
+	return res, body, token, nil
+}
+
+// tokenError - The access token could not be acquired, so the request has not been sent
+type tokenError struct {
+	err error
+}
+
+func (e *tokenError) Error() string { return e.err.Error() }
+func (e *tokenError) Unwrap() error { return e.err }
+
+// isRetryable - Transient errors are retried only for idempotent methods, unless configured otherwise
+func (c *Client) isRetryable(req *http.Request, res *http.Response, err error) bool {
+	if _, ok := err.(*tokenError); ok {
+		return false
+	}
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+	default:
+		if !c.RetryNonIdempotent {
+			return false
+		}
+	}
+	if err != nil {
+		//network errors, the request could not be completed
+		return req.Context().Err() == nil
+	}
+	switch res.StatusCode {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// waitRetry - Wait the time indicated by the Retry-After header, or an exponential backoff with jitter otherwise
+func (c *Client) waitRetry(req *http.Request, res *http.Response, attempt int) error {
+	wait := c.RetryMinWait << attempt
+	if wait > c.RetryMaxWait || wait < c.RetryMinWait {
+		wait = c.RetryMaxWait
+	}
+	wait = wait/2 + time.Duration(rand.Int63n(int64(wait/2)+1))
+	if res != nil {
+		if retryAfter, ok := parseRetryAfter(res.Header.Get("Retry-After")); ok {
+			wait = min(retryAfter, c.RetryMaxWait)
+		}
 	}
 
-	//write error not StatusOK
-	if res.StatusCode != http.StatusOK && res.StatusCode != http.StatusCreated && res.StatusCode != http.StatusAccepted && res.StatusCode != http.StatusNoContent {
-		return nil, fmt.Errorf("status: %d, body: %s", res.StatusCode, body)
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-req.Context().Done():
+		return req.Context().Err()
+	case <-timer.C:
+		return nil
 	}
+}
 
-	return body, err
+// parseRetryAfter - Parse the Retry-After header value, that can be expressed in seconds or as a http date
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		return max(time.Until(date), 0), true
+	}
+	return 0, false
+}
+
+// rewindRequest - Returns a copy of the request with the body ready to be sent again
+func rewindRequest(req *http.Request) (*http.Request, error) {
+	retry := req.Clone(req.Context())
+	if req.Body != nil && req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		retry.Body = body
+	}
+	return retry, nil
 }
@@ -0,0 +1,251 @@
+package azureipamclient
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// newTestClient returns a client for the stand-in engine, with short waits between retries.
+func newTestClient(t *testing.T, handler http.HandlerFunc) (*Client, *httptest.Server) {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	token := "dummyForTesting"
+	client, err := NewClient(&server.URL, &token, nil, false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	client.RetryMinWait = time.Millisecond
+	client.RetryMaxWait = 10 * time.Millisecond
+	return client, server
+}
+
+// failingHandler returns the status code for the first failures requests, and the body afterwards.
+func failingHandler(calls *atomic.Int32, failures int32, statusCode int, body string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) <= failures {
+			w.WriteHeader(statusCode)
+			return
+		}
+		_, _ = w.Write([]byte(body))
+	}
+}
+
+func TestDoRequestRetriesTransientErrors(t *testing.T) {
+	for _, statusCode := range []int{http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout} {
+		var calls atomic.Int32
+		client, server := newTestClient(t, failingHandler(&calls, 2, statusCode, "ok"))
+		req, _ := http.NewRequest("GET", server.URL+"/api/spaces", nil)
+
+		body, err := client.doRequest(req)
+		if err != nil || string(body) != "ok" {
+			t.Errorf("status %d: expected ok, got %q, %v", statusCode, body, err)
+		}
+		if calls.Load() != 3 {
+			t.Errorf("status %d: expected 3 requests, got %d", statusCode, calls.Load())
+		}
+	}
+}
+
+func TestDoRequestStopsAfterMaxRetries(t *testing.T) {
+	var calls atomic.Int32
+	client, server := newTestClient(t, failingHandler(&calls, 100, http.StatusServiceUnavailable, "ok"))
+	client.MaxRetries = 2
+	req, _ := http.NewRequest("GET", server.URL+"/api/spaces", nil)
+
+	_, err := client.doRequest(req)
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusServiceUnavailable {
+		t.Errorf("expected a 503 API error, got %v", err)
+	}
+	if calls.Load() != 3 {
+		t.Errorf("expected 3 requests, got %d", calls.Load())
+	}
+}
+
+func TestDoRequestDoesNotRetryOtherErrors(t *testing.T) {
+	var calls atomic.Int32
+	client, server := newTestClient(t, failingHandler(&calls, 100, http.StatusBadRequest, "ok"))
+	req, _ := http.NewRequest("GET", server.URL+"/api/spaces", nil)
+
+	if _, err := client.doRequest(req); !IsBadRequest(err) {
+		t.Errorf("expected a bad request error, got %v", err)
+	}
+	if calls.Load() != 1 {
+		t.Errorf("expected 1 request, got %d", calls.Load())
+	}
+}
+
+func TestDoRequestNonIdempotentRequests(t *testing.T) {
+	var calls atomic.Int32
+	client, server := newTestClient(t, failingHandler(&calls, 1, http.StatusServiceUnavailable, "ok"))
+
+	//POST requests are not retried by default
+	req, _ := http.NewRequest("POST", server.URL+"/api/spaces", strings.NewReader("{}"))
+	if _, err := client.doRequest(req); err == nil {
+		t.Errorf("expected the POST request to fail without retries")
+	}
+	if calls.Load() != 1 {
+		t.Errorf("expected 1 request, got %d", calls.Load())
+	}
+
+	//unless it's allowed
+	calls.Store(0)
+	client.RetryNonIdempotent = true
+	req, _ = http.NewRequest("POST", server.URL+"/api/spaces", strings.NewReader("{}"))
+	if body, err := client.doRequest(req); err != nil || string(body) != "ok" {
+		t.Errorf("expected ok, got %q, %v", body, err)
+	}
+	if calls.Load() != 2 {
+		t.Errorf("expected 2 requests, got %d", calls.Load())
+	}
+}
+
+func TestDoRequestReplaysBody(t *testing.T) {
+	var calls atomic.Int32
+	client, server := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if string(body) != `["a","b"]` {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if calls.Add(1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+	req, _ := http.NewRequest("PUT", server.URL+"/api/admin/exclusions", strings.NewReader(`["a","b"]`))
+
+	if _, err := client.doRequest(req); err != nil {
+		t.Errorf("expected the body to be sent again on the retry, got %v", err)
+	}
+	if calls.Load() != 2 {
+		t.Errorf("expected 2 requests, got %d", calls.Load())
+	}
+}
+
+func TestDoRequestHonoursRetryAfter(t *testing.T) {
+	var calls atomic.Int32
+	client, server := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		_, _ = w.Write([]byte("ok"))
+	})
+	//the backoff would wait for an hour, so the request only completes if the Retry-After header is used
+	client.RetryMinWait = time.Hour
+	client.RetryMaxWait = time.Hour
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	req, _ := http.NewRequestWithContext(ctx, "GET", server.URL+"/api/spaces", nil)
+
+	if body, err := client.doRequest(req); err != nil || string(body) != "ok" {
+		t.Errorf("expected ok, got %q, %v", body, err)
+	}
+}
+
+func TestDoRequestLimitsRetryAfter(t *testing.T) {
+	var calls atomic.Int32
+	client, server := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) == 1 {
+			w.Header().Set("Retry-After", "3600")
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write([]byte("ok"))
+	})
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	req, _ := http.NewRequestWithContext(ctx, "GET", server.URL+"/api/spaces", nil)
+
+	if body, err := client.doRequest(req); err != nil || string(body) != "ok" {
+		t.Errorf("expected the Retry-After wait to be limited by RetryMaxWait, got %q, %v", body, err)
+	}
+}
+
+func TestDoRequestCancelledWhileWaiting(t *testing.T) {
+	var calls atomic.Int32
+	client, server := newTestClient(t, failingHandler(&calls, 100, http.StatusServiceUnavailable, "ok"))
+	client.RetryMinWait = time.Hour
+	client.RetryMaxWait = time.Hour
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	req, _ := http.NewRequestWithContext(ctx, "GET", server.URL+"/api/spaces", nil)
+
+	if _, err := client.doRequest(req); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected the deadline to stop the retries, got %v", err)
+	}
+	if calls.Load() != 1 {
+		t.Errorf("expected 1 request, got %d", calls.Load())
+	}
+}
+
+func TestDoRequestRenewsTokenOnUnauthorized(t *testing.T) {
+	//the first token is rejected, as if it had been revoked before its expiration
+	var calls atomic.Int32
+	client, server := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		if r.Header.Get("Authorization") != "Bearer token-2" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write([]byte("ok"))
+	})
+	credential := &fakeCredential{lifetime: time.Hour}
+	client.TokenSource = &refreshingTokenSource{client: client, credential: credential}
+	req, _ := http.NewRequest("GET", server.URL+"/api/spaces", nil)
+
+	if body, err := client.doRequest(req); err != nil || string(body) != "ok" {
+		t.Errorf("expected ok, got %q, %v", body, err)
+	}
+	if calls.Load() != 2 || credential.calls.Load() != 2 {
+		t.Errorf("expected 2 requests and 2 tokens, got %d and %d", calls.Load(), credential.calls.Load())
+	}
+}
+
+func TestDoRequestUnauthorizedWithStaticToken(t *testing.T) {
+	//a static token can't be renewed, so the request is not repeated
+	var calls atomic.Int32
+	client, server := newTestClient(t, failingHandler(&calls, 100, http.StatusUnauthorized, "ok"))
+	req, _ := http.NewRequest("GET", server.URL+"/api/spaces", nil)
+
+	if _, err := client.doRequest(req); !IsUnauthorized(err) {
+		t.Errorf("expected an unauthorized error, got %v", err)
+	}
+	if calls.Load() != 1 {
+		t.Errorf("expected 1 request, got %d", calls.Load())
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	tests := []struct {
+		value string
+		ok    bool
+		min   time.Duration
+		max   time.Duration
+	}{
+		{value: "", ok: false},
+		{value: "invalid", ok: false},
+		{value: "-1", ok: false},
+		{value: "0", ok: true, min: 0, max: 0},
+		{value: "120", ok: true, min: 2 * time.Minute, max: 2 * time.Minute},
+		{value: time.Now().Add(time.Minute).UTC().Format(http.TimeFormat), ok: true, min: 58 * time.Second, max: time.Minute},
+		{value: time.Now().Add(-time.Minute).UTC().Format(http.TimeFormat), ok: true, min: 0, max: 0},
+	}
+	for _, test := range tests {
+		wait, ok := parseRetryAfter(test.value)
+		if ok != test.ok || wait < test.min || wait > test.max {
+			t.Errorf("%q: expected %t between %s and %s, got %t %s", test.value, test.ok, test.min, test.max, ok, wait)
+		}
+	}
+}
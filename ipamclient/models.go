@@ -60,9 +60,10 @@ type SubnetInfo struct {
 
 //ExternalInfo
 type ExternalInfo struct {
-	Name        string `json:"name,omitempty"`
-	Description string `json:"desc,omitempty"`
-	Cidr        string `json:"cidr,omitempty"`
+	Name        string               `json:"name,omitempty"`
+	Description string               `json:"desc,omitempty"`
+	Cidr        string               `json:"cidr,omitempty"`
+	Subnets     []ExternalSubnetInfo `json:"subnets,omitempty"`
 }
 
 //ReservationInfo
@@ -93,12 +94,103 @@ type External struct {
 	Cidr        string `json:"cidr,omitempty"`
 }
 
+//ExternalSubnetInfo
+type ExternalSubnetInfo struct {
+	Name        string `json:"name,omitempty"`
+	Description string `json:"desc,omitempty"`
+	Cidr        string `json:"cidr,omitempty"`
+}
+
+//ExternalSubnet
+type ExternalSubnet struct {
+	Space       string `json:"space,omitempty"`
+	Block       string `json:"block,omitempty"`
+	External    string `json:"external,omitempty"`
+	Name        string `json:"name,omitempty"`
+	Description string `json:"desc,omitempty"`
+	Cidr        string `json:"cidr,omitempty"`
+}
+
 //BlockNetworkInfo
 type BlockNetworkInfo struct {
 	Name           string   `json:"name,omitempty"`
 	Id             string   `json:"id,omitempty"`
+	Type           string   `json:"-"`
 	Prefixes       []string `json:"prefixes,omitempty"`
+	AddressPrefix  *string  `json:"address_prefix,omitempty"`
+	ResourceGroup  *string  `json:"resource_group,omitempty"`
+	SubscriptionId *string  `json:"subscription_id,omitempty"`
+	TenantId       *string  `json:"tenant_id,omitempty"`
+}
+
+//NextAvailableVnet
+type NextAvailableVnet struct {
+	Space string `json:"space,omitempty"`
+	Block string `json:"block,omitempty"`
+	Cidr  string `json:"cidr,omitempty"`
+}
+
+//NextAvailableSubnet
+type NextAvailableSubnet struct {
+	VnetName       string `json:"vnet_name,omitempty"`
+	ResourceGroup  string `json:"resource_group,omitempty"`
+	SubscriptionId string `json:"subscription_id,omitempty"`
+	Cidr           string `json:"cidr,omitempty"`
+}
+
+//Admin
+type Admin struct {
+	Type  string  `json:"type,omitempty"`
+	Id    string  `json:"id,omitempty"`
+	Name  string  `json:"name,omitempty"`
+	Email *string `json:"email,omitempty"`
+}
+
+//AzureVnet
+type AzureVnet struct {
+	Name           string       `json:"name,omitempty"`
+	Id             string       `json:"id,omitempty"`
+	Prefixes       []string     `json:"prefixes,omitempty"`
+	Subnets        []SubnetInfo `json:"subnets,omitempty"`
+	ResourceGroup  *string      `json:"resource_group,omitempty"`
+	SubscriptionId *string      `json:"subscription_id,omitempty"`
+	TenantId       *string      `json:"tenant_id,omitempty"`
+	Size           *float64     `json:"size,omitempty"`
+	Used           *float64     `json:"used,omitempty"`
+	ParentSpace    *string      `json:"parent_space,omitempty"`
+	ParentBlock    *string      `json:"parent_block,omitempty"`
+}
+
+//AzureSubnet
+type AzureSubnet struct {
+	Name           string   `json:"name,omitempty"`
+	Id             string   `json:"id,omitempty"`
+	Prefix         string   `json:"prefix,omitempty"`
+	VnetName       *string  `json:"vnet_name,omitempty"`
+	Type           *string  `json:"type,omitempty"`
 	ResourceGroup  *string  `json:"resource_group,omitempty"`
 	SubscriptionId *string  `json:"subscription_id,omitempty"`
 	TenantId       *string  `json:"tenant_id,omitempty"`
+	Size           *float64 `json:"size,omitempty"`
+	Used           *float64 `json:"used,omitempty"`
+}
+
+//AzureEndpoint
+type AzureEndpoint struct {
+	Name           string  `json:"name,omitempty"`
+	Id             string  `json:"id,omitempty"`
+	PrivateIp      *string `json:"private_ip,omitempty"`
+	VnetName       *string `json:"vnet_name,omitempty"`
+	VnetId         *string `json:"vnet_id,omitempty"`
+	SubnetName     *string `json:"subnet_name,omitempty"`
+	SubnetId       *string `json:"subnet_id,omitempty"`
+	ResourceGroup  *string `json:"resource_group,omitempty"`
+	SubscriptionId *string `json:"subscription_id,omitempty"`
+	TenantId       *string `json:"tenant_id,omitempty"`
+}
+
+//EngineStatus
+type EngineStatus struct {
+	Status  string `json:"status,omitempty"`
+	Version string `json:"version,omitempty"`
 }
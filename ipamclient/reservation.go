@@ -1,6 +1,7 @@
 package azureipamclient
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -8,29 +9,41 @@ import (
 	"strings"
 )
 
+const (
+	// ReservationStatusWait - The reservation is waiting for the creation of a vnet with its X-IPAM-RES-ID tag
+	ReservationStatusWait = "wait"
+	// ReservationStatusFulfilled - The reservation has been settled by a vnet with its X-IPAM-RES-ID tag
+	ReservationStatusFulfilled = "fulfilled"
+	// ReservationStatusCancelledByUser - The reservation has been deleted by a user, the engine keeps it as settled with this status
+	ReservationStatusCancelledByUser = "cancelledByUser"
+)
+
 // internal Models
 type reservationSpaceRequest struct {
-	Blocks        []string `json:"blocks"`
-	Size          *int32   `json:"size"`
-	Description   *string  `json:"desc"`
-	ReverseSearch bool     `json:"reverse_search"`
-	SmallestCidr  bool     `json:"smallest_cidr"`
+	Blocks        []string          `json:"blocks"`
+	Size          *int32            `json:"size"`
+	Description   *string           `json:"desc"`
+	ReverseSearch bool              `json:"reverse_search"`
+	SmallestCidr  bool              `json:"smallest_cidr"`
+	Tags          map[string]string `json:"tags,omitempty"`
 }
 type reservationBlockSizeRequest struct {
-	Size          int32   `json:"size"`
-	Description   *string `json:"desc"`
-	ReverseSearch bool    `json:"reverse_search"`
-	SmallestCidr  bool    `json:"smallest_cidr"`
+	Size          int32             `json:"size"`
+	Description   *string           `json:"desc"`
+	ReverseSearch bool              `json:"reverse_search"`
+	SmallestCidr  bool              `json:"smallest_cidr"`
+	Tags          map[string]string `json:"tags,omitempty"`
 }
 type reservationBlockCidrRequest struct {
-	Cidr        string  `json:"cidr"`
-	Description *string `json:"desc"`
+	Cidr        string            `json:"cidr"`
+	Description *string           `json:"desc"`
+	Tags        map[string]string `json:"tags,omitempty"`
 }
 
 // GetReservations - Returns all existing reservations by space and block
-func (c *Client) GetReservations(space, block string, includeSettled bool) (*[]Reservation, error) {
+func (c *Client) GetReservations(ctx context.Context, space, block string, includeSettled bool) (*[]Reservation, error) {
 	//prepare request
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/api/spaces/%s/blocks/%s/reservations?settled=%t", c.HostURL, space, block, includeSettled), nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/api/spaces/%s/blocks/%s/reservations?settled=%t", c.HostURL, space, block, includeSettled), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -50,10 +63,10 @@ func (c *Client) GetReservations(space, block string, includeSettled bool) (*[]R
 }
 
 // GetReservation - Search for a specifc reservation ID iterating spaces and blocks
-func (c *Client) FindReservationById(id string) (*Reservation, error) {
+func (c *Client) FindReservationById(ctx context.Context, id string) (*Reservation, error) {
 
 	//read all reservations
-	spaces, err := c.GetSpaces(false, false)
+	spaces, err := c.GetSpaces(ctx, false, false)
 	if err != nil {
 		return nil, err
 	}
@@ -63,38 +76,47 @@ func (c *Client) FindReservationById(id string) (*Reservation, error) {
 		for _, block := range space.Blocks {
 			for _, reservation := range block.Reservations {
 				if reservation.Id == id {
-					return c.GetReservation(space.Name, block.Name, reservation.Id)
+					return c.GetReservation(ctx, space.Name, block.Name, reservation.Id)
 				}
 			}
 		}
 	}
 
 	//not found -> Error
-	return nil, fmt.Errorf("Reservation not found: %s", id)
+	return nil, fmt.Errorf("reservation %s %w", id, ErrNotFound)
 }
 
-// GetReservation - Search for a specifc reservation ID iterating spaces and blocks
-func (c *Client) GetReservation(space string, block string, id string) (*Reservation, error) {
-
-	//read all reservations
-	reservationsInfo, err := c.GetReservations(space, block, true)
+// GetReservation - Returns a specifc reservation by space, block and id
+func (c *Client) GetReservation(ctx context.Context, space string, block string, id string) (*Reservation, error) {
+	//prepare request
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/api/spaces/%s/blocks/%s/reservations/%s", c.HostURL, space, block, id), nil)
+	if err != nil {
+		return nil, err
+	}
+	response, err := c.doRequest(req)
 	if err != nil {
 		return nil, err
 	}
 
-	//find the reservation by Id, and returns
-	for _, reservation := range *reservationsInfo {
-		if reservation.Id == id {
-			return &reservation, nil
-		}
+	//process response
+	var reservation Reservation
+	err = json.Unmarshal(response, &reservation)
+	if err != nil {
+		return nil, err
+	}
+	//add attributes not included in response
+	if reservation.Space == "" {
+		reservation.Space = space
+	}
+	if reservation.Block == "" {
+		reservation.Block = block
 	}
 
-	//not found -> Error
-	return nil, fmt.Errorf("Reservation not found: %s", id)
+	return &reservation, nil
 }
 
-// CreateReservation - Create new reservation
-func (c *Client) CreateReservation(space string, blocks []string, description *string, size *int32, specific_cidr *string, reverseSearch bool, smallestCidr bool) (*Reservation, error) {
+// CreateReservation - Create new reservation, the user tags are added to the tags generated by IPAM
+func (c *Client) CreateReservation(ctx context.Context, space string, blocks []string, description *string, tags map[string]string, size *int32, specific_cidr *string, reverseSearch bool, smallestCidr bool) (*Reservation, error) {
 	//validate params
 	if size == nil && specific_cidr == nil {
 		return nil, errors.New("at least one of size or specific_cidr must be specified to create a reservation")
@@ -113,12 +135,13 @@ func (c *Client) CreateReservation(space string, blocks []string, description *s
 			request := &reservationBlockCidrRequest{
 				Cidr:        *specific_cidr,
 				Description: description,
+				Tags:        tags,
 			}
 			rb, err := json.Marshal(request)
 			if err != nil {
 				return nil, err
 			}
-			req, errReq = http.NewRequest("POST", fmt.Sprintf("%s/api/spaces/%s/blocks/%s/reservations", c.HostURL, space, blocks[0]), strings.NewReader(string(rb)))
+			req, errReq = http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/api/spaces/%s/blocks/%s/reservations", c.HostURL, space, blocks[0]), strings.NewReader(string(rb)))
 			if errReq != nil {
 				return nil, err
 			}
@@ -129,12 +152,13 @@ func (c *Client) CreateReservation(space string, blocks []string, description *s
 				ReverseSearch: reverseSearch,
 				SmallestCidr:  smallestCidr,
 				Description:   description,
+				Tags:          tags,
 			}
 			rb, err := json.Marshal(request)
 			if err != nil {
 				return nil, err
 			}
-			req, errReq = http.NewRequest("POST", fmt.Sprintf("%s/api/spaces/%s/blocks/%s/reservations", c.HostURL, space, blocks[0]), strings.NewReader(string(rb)))
+			req, errReq = http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/api/spaces/%s/blocks/%s/reservations", c.HostURL, space, blocks[0]), strings.NewReader(string(rb)))
 			if errReq != nil {
 				return nil, err
 			}
@@ -147,12 +171,13 @@ func (c *Client) CreateReservation(space string, blocks []string, description *s
 			ReverseSearch: reverseSearch,
 			SmallestCidr:  smallestCidr,
 			Description:   description,
+			Tags:          tags,
 		}
 		rb, err := json.Marshal(request)
 		if err != nil {
 			return nil, err
 		}
-		req, errReq = http.NewRequest("POST", fmt.Sprintf("%s/api/spaces/%s/reservations", c.HostURL, space), strings.NewReader(string(rb)))
+		req, errReq = http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/api/spaces/%s/reservations", c.HostURL, space), strings.NewReader(string(rb)))
 		if errReq != nil {
 			return nil, err
 		}
@@ -176,8 +201,14 @@ func (c *Client) CreateReservation(space string, blocks []string, description *s
 	return &reservation, nil
 }
 
+// IsReservationClosed - The reservation has been cancelled or has failed, so it no longer reserves its cidr.
+// The engine doesn't delete the reservations, they are kept as settled with the cancelled or error status.
+func IsReservationClosed(reservation *Reservation) bool {
+	return reservation.Status == ReservationStatusCancelledByUser || strings.HasPrefix(reservation.Status, "err")
+}
+
 // DeleteReservation - Deletes a reservation
-func (c *Client) DeleteReservation(space, block, id string) error {
+func (c *Client) DeleteReservation(ctx context.Context, space, block, id string) error {
 	//construct body
 	request := [1]string{id}
 	rb, err := json.Marshal(request)
@@ -186,7 +217,7 @@ func (c *Client) DeleteReservation(space, block, id string) error {
 	}
 
 	//prepare request
-	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/api/spaces/%s/blocks/%s/reservations", c.HostURL, space, block), strings.NewReader(string(rb)))
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/api/spaces/%s/blocks/%s/reservations", c.HostURL, space, block), strings.NewReader(string(rb)))
 	if err != nil {
 		return err
 	}
@@ -1,6 +1,7 @@
 package azureipamclient
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -20,9 +21,9 @@ type spaceUpdateRequest struct {
 }
 
 // GetSpaces - Returns all existing spaces
-func (c *Client) GetSpaces(expand bool, appendUtilization bool) (*[]SpaceInfo, error) {
+func (c *Client) GetSpaces(ctx context.Context, expand bool, appendUtilization bool) (*[]SpaceInfo, error) {
 	//prepare request
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/api/spaces?expand=%t&utilization=%t", c.HostURL, expand, appendUtilization), nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/api/spaces?expand=%t&utilization=%t", c.HostURL, expand, appendUtilization), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -42,10 +43,10 @@ func (c *Client) GetSpaces(expand bool, appendUtilization bool) (*[]SpaceInfo, e
 }
 
 // GetSpace - Returns a specifc space by name
-func (c *Client) GetSpace(name string, expand bool, appendUtilization bool) (*SpaceInfo, error) {
+func (c *Client) GetSpace(ctx context.Context, name string, expand bool, appendUtilization bool) (*SpaceInfo, error) {
 
 	//prepare request
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/api/spaces/%s?expand=%t&utilization=%t", c.HostURL, name, expand, appendUtilization), nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/api/spaces/%s?expand=%t&utilization=%t", c.HostURL, name, expand, appendUtilization), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -65,7 +66,7 @@ func (c *Client) GetSpace(name string, expand bool, appendUtilization bool) (*Sp
 }
 
 // CreateSpace - Create new space
-func (c *Client) CreateSpace(name string, description string) (*SpaceInfo, error) {
+func (c *Client) CreateSpace(ctx context.Context, name string, description string) (*SpaceInfo, error) {
 
 	//construct body
 	request := &spaceRequest{
@@ -78,7 +79,7 @@ func (c *Client) CreateSpace(name string, description string) (*SpaceInfo, error
 	}
 
 	//prepare request
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/api/spaces", c.HostURL), strings.NewReader(string(rb)))
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/api/spaces", c.HostURL), strings.NewReader(string(rb)))
 	if err != nil {
 		return nil, err
 	}
@@ -97,7 +98,7 @@ func (c *Client) CreateSpace(name string, description string) (*SpaceInfo, error
 	return &space, nil
 }
 
-func (c *Client) UpdateSpace(name string, newName *string, newDescription *string) (*SpaceInfo, error) {
+func (c *Client) UpdateSpace(ctx context.Context, name string, newName *string, newDescription *string) (*SpaceInfo, error) {
 
 	//construct body
 	var request = []spaceUpdateRequest{}
@@ -122,17 +123,17 @@ func (c *Client) UpdateSpace(name string, newName *string, newDescription *strin
 	}
 
 	//prepare request
-	req, err := http.NewRequest("PATCH", fmt.Sprintf("%s/api/spaces/%s", c.HostURL, name), strings.NewReader(string(rb)))
+	req, err := http.NewRequestWithContext(ctx, "PATCH", fmt.Sprintf("%s/api/spaces/%s", c.HostURL, name), strings.NewReader(string(rb)))
 	if err != nil {
 		return nil, err
 	}
-	response, err := c.doRequest(req)
+	_, err = c.doRequest(req)
 	if err != nil {
-		return nil, errors.New(string(response) + ";inner error: " + err.Error())
+		return nil, err
 	}
 
 	//read and return the updated space
-	retVal, err := c.GetSpace(*newName, false, false)
+	retVal, err := c.GetSpace(ctx, *newName, false, false)
 	if err != nil {
 		return nil, err
 	}
@@ -140,10 +141,10 @@ func (c *Client) UpdateSpace(name string, newName *string, newDescription *strin
 }
 
 // DeleteSpace- Deletes a space
-func (c *Client) DeleteSpace(name string, force bool) error {
+func (c *Client) DeleteSpace(ctx context.Context, name string, force bool) error {
 
 	//prepare request
-	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/api/spaces/%s?force=%t", c.HostURL, name, force), nil)
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/api/spaces/%s?force=%t", c.HostURL, name, force), nil)
 	if err != nil {
 		return err
 	}
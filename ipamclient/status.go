@@ -0,0 +1,83 @@
+package azureipamclient
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// MinEngineVersion - Oldest IPAM engine version supported by the client. It's the Azure IPAM 2.0.0 release, the first one
+// including the changes published on 18 April 2023 (https://github.com/Azure/ipam/pull/113), see https://github.com/Azure/ipam/releases
+const MinEngineVersion = "2.0.0"
+
+// GetStatus - Returns the status and version of the IPAM engine.
+// The request is sent only once, without retries, since it's used to check the engine compatibility when the client is configured.
+func (c *Client) GetStatus(ctx context.Context) (*EngineStatus, error) {
+	//prepare request
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/api/status", c.HostURL), nil)
+	if err != nil {
+		return nil, err
+	}
+	res, body, _, err := c.send(req)
+	if err != nil {
+		return nil, err
+	}
+	if res.StatusCode != http.StatusOK {
+		return nil, newAPIError(req, res, body)
+	}
+
+	//process response
+	var status EngineStatus
+	err = json.Unmarshal(body, &status)
+	if err != nil {
+		return nil, err
+	}
+
+	return &status, nil
+}
+
+// CompareEngineVersions - Compares two engine versions in the major.minor.patch format, returning -1, 0 or 1.
+// A leading 'v' and any pre-release or build suffix are ignored, and missing components are considered 0.
+func CompareEngineVersions(a string, b string) (int, error) {
+	va, err := parseEngineVersion(a)
+	if err != nil {
+		return 0, err
+	}
+	vb, err := parseEngineVersion(b)
+	if err != nil {
+		return 0, err
+	}
+	for i := range va {
+		if va[i] != vb[i] {
+			if va[i] < vb[i] {
+				return -1, nil
+			}
+			return 1, nil
+		}
+	}
+	return 0, nil
+}
+
+// parseEngineVersion - Returns the major, minor and patch components of the version
+func parseEngineVersion(version string) ([3]int, error) {
+	var components [3]int
+	trimmed := strings.TrimPrefix(strings.TrimSpace(version), "v")
+	if i := strings.IndexAny(trimmed, "-+"); i >= 0 {
+		trimmed = trimmed[:i]
+	}
+	parts := strings.Split(trimmed, ".")
+	if len(parts) > len(components) {
+		return components, fmt.Errorf("invalid engine version %q", version)
+	}
+	for i, part := range parts {
+		n, err := strconv.Atoi(part)
+		if err != nil || n < 0 {
+			return components, fmt.Errorf("invalid engine version %q", version)
+		}
+		components[i] = n
+	}
+	return components, nil
+}
@@ -0,0 +1,38 @@
+package azureipamclient
+
+import "testing"
+
+func TestCompareEngineVersions(t *testing.T) {
+	tests := []struct {
+		a        string
+		b        string
+		expected int
+	}{
+		{a: "3.4.0", b: "2.0.0", expected: 1},
+		{a: "2.0.0", b: "2.0.0", expected: 0},
+		{a: "1.9.9", b: "2.0.0", expected: -1},
+		{a: "2.10.0", b: "2.9.0", expected: 1},
+		{a: "v2.0.0", b: "2.0.0", expected: 0},
+		{a: "2", b: "2.0.0", expected: 0},
+		{a: "2.0.1-beta+build.5", b: "2.0.1", expected: 0},
+		{a: " 3.0 ", b: "3.0.1", expected: -1},
+	}
+	for _, test := range tests {
+		cmp, err := CompareEngineVersions(test.a, test.b)
+		if err != nil {
+			t.Errorf("%s vs %s: unexpected error: %v", test.a, test.b, err)
+			continue
+		}
+		if cmp != test.expected {
+			t.Errorf("%s vs %s: expected %d, got %d", test.a, test.b, test.expected, cmp)
+		}
+	}
+}
+
+func TestCompareEngineVersionsInvalid(t *testing.T) {
+	for _, version := range []string{"", "latest", "1.2.3.4", "1.-2.0", "1..0"} {
+		if _, err := CompareEngineVersions(version, "2.0.0"); err == nil {
+			t.Errorf("%q: expected an invalid version error", version)
+		}
+	}
+}
@@ -0,0 +1,66 @@
+package azureipamclient
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// tokenRefreshMargin - Time before the expiration at which the access tokens are renewed, to prevent its expiration in the middle of a request
+const tokenRefreshMargin = 5 * time.Minute
+
+// TokenSource - Provides the bearer tokens used to authenticate the API requests
+type TokenSource interface {
+	// Token returns a valid bearer token, renewing it when needed
+	Token(ctx context.Context) (string, error)
+	// Invalidate discards the current token, forcing its renewal on the next call to Token
+	Invalidate()
+}
+
+// NewStaticTokenSource - Construct a TokenSource that always returns the same token
+func NewStaticTokenSource(token string) TokenSource {
+	return &staticTokenSource{token: token}
+}
+
+// staticTokenSource - Bearer token provided by the user, that can't be renewed
+type staticTokenSource struct {
+	token string
+}
+
+func (s *staticTokenSource) Token(_ context.Context) (string, error) {
+	return s.token, nil
+}
+
+func (s *staticTokenSource) Invalidate() {}
+
+// refreshingTokenSource - Access tokens acquired from a credential, cached and renewed before they expire
+type refreshingTokenSource struct {
+	client     *Client
+	credential tokenCredential
+
+	mu     sync.Mutex
+	cached *accessToken
+}
+
+func (s *refreshingTokenSource) Token(ctx context.Context) (string, error) {
+	//serialize the renewal, resources and data sources are managed concurrently
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.cached == nil || time.Now().Add(tokenRefreshMargin).After(s.cached.ExpiresOn) {
+		token, err := s.credential.getToken(ctx, s.client)
+		if err != nil {
+			return "", err
+		}
+		s.cached = token
+	}
+
+	return s.cached.Token, nil
+}
+
+func (s *refreshingTokenSource) Invalidate() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.cached = nil
+}
@@ -0,0 +1,92 @@
+package azureipamclient
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// fakeCredential returns a new token on each call, expiring after the configured lifetime.
+type fakeCredential struct {
+	lifetime time.Duration
+	delay    time.Duration
+	calls    atomic.Int32
+}
+
+func (cred *fakeCredential) getToken(_ context.Context, _ *Client) (*accessToken, error) {
+	call := cred.calls.Add(1)
+	time.Sleep(cred.delay)
+	return &accessToken{
+		Token:     fmt.Sprintf("token-%d", call),
+		ExpiresOn: time.Now().Add(cred.lifetime),
+	}, nil
+}
+
+func TestRefreshingTokenSourceCachesToken(t *testing.T) {
+	credential := &fakeCredential{lifetime: time.Hour}
+	source := &refreshingTokenSource{credential: credential}
+
+	for i := 0; i < 3; i++ {
+		token, err := source.Token(context.Background())
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if token != "token-1" {
+			t.Errorf("expected the cached token-1, got %s", token)
+		}
+	}
+	if calls := credential.calls.Load(); calls != 1 {
+		t.Errorf("expected 1 token request, got %d", calls)
+	}
+}
+
+func TestRefreshingTokenSourceRenewsBeforeExpiry(t *testing.T) {
+	//the token expires inside the refresh margin, so it's renewed on every call
+	credential := &fakeCredential{lifetime: tokenRefreshMargin - time.Minute}
+	source := &refreshingTokenSource{credential: credential}
+
+	first, _ := source.Token(context.Background())
+	second, _ := source.Token(context.Background())
+	if first == second {
+		t.Errorf("expected the token to be renewed, got %s twice", first)
+	}
+	if calls := credential.calls.Load(); calls != 2 {
+		t.Errorf("expected 2 token requests, got %d", calls)
+	}
+}
+
+func TestRefreshingTokenSourceInvalidate(t *testing.T) {
+	credential := &fakeCredential{lifetime: time.Hour}
+	source := &refreshingTokenSource{credential: credential}
+
+	first, _ := source.Token(context.Background())
+	source.Invalidate()
+	second, _ := source.Token(context.Background())
+	if first != "token-1" || second != "token-2" {
+		t.Errorf("expected token-1 and token-2, got %s and %s", first, second)
+	}
+}
+
+func TestRefreshingTokenSourceConcurrentRequests(t *testing.T) {
+	//the renewal is serialized, so the concurrent callers share a single token request
+	credential := &fakeCredential{lifetime: time.Hour, delay: 20 * time.Millisecond}
+	source := &refreshingTokenSource{credential: credential}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if token, err := source.Token(context.Background()); err != nil || token != "token-1" {
+				t.Errorf("expected token-1, got %s, %v", token, err)
+			}
+		}()
+	}
+	wg.Wait()
+	if calls := credential.calls.Load(); calls != 1 {
+		t.Errorf("expected 1 token request, got %d", calls)
+	}
+}
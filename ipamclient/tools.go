@@ -0,0 +1,99 @@
+package azureipamclient
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// internal Models
+type nextAvailableVnetRequest struct {
+	Space         string   `json:"space"`
+	Blocks        []string `json:"blocks"`
+	Size          int32    `json:"size"`
+	ReverseSearch bool     `json:"reverse_search"`
+	SmallestCidr  bool     `json:"smallest_cidr"`
+}
+type nextAvailableSubnetRequest struct {
+	VnetId        string `json:"vnet_id"`
+	Size          int32  `json:"size"`
+	ReverseSearch bool   `json:"reverse_search"`
+	SmallestCidr  bool   `json:"smallest_cidr"`
+}
+
+// GetNextAvailableVnet - Returns the next available cidr of the requested size in the blocks of a space, without reserving it
+func (c *Client) GetNextAvailableVnet(ctx context.Context, space string, blocks []string, size int32, reverseSearch bool, smallestCidr bool) (*NextAvailableVnet, error) {
+	//validate params
+	if len(blocks) == 0 {
+		return nil, errors.New("at least one block must be specified")
+	}
+
+	//construct body
+	request := &nextAvailableVnetRequest{
+		Space:         space,
+		Blocks:        blocks,
+		Size:          size,
+		ReverseSearch: reverseSearch,
+		SmallestCidr:  smallestCidr,
+	}
+	rb, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+
+	//prepare request
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/api/tools/nextAvailableVNet", c.HostURL), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+	response, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	//process response
+	nextAvailable := NextAvailableVnet{}
+	err = json.Unmarshal(response, &nextAvailable)
+	if err != nil {
+		return nil, err
+	}
+
+	return &nextAvailable, nil
+}
+
+// GetNextAvailableSubnet - Returns the next available cidr of the requested size in a virtual network, without creating the subnet
+func (c *Client) GetNextAvailableSubnet(ctx context.Context, vnetId string, size int32, reverseSearch bool, smallestCidr bool) (*NextAvailableSubnet, error) {
+	//construct body
+	request := &nextAvailableSubnetRequest{
+		VnetId:        vnetId,
+		Size:          size,
+		ReverseSearch: reverseSearch,
+		SmallestCidr:  smallestCidr,
+	}
+	rb, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+
+	//prepare request
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/api/tools/nextAvailableSubnet", c.HostURL), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+	response, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	//process response
+	nextAvailable := NextAvailableSubnet{}
+	err = json.Unmarshal(response, &nextAvailable)
+	if err != nil {
+		return nil, err
+	}
+
+	return &nextAvailable, nil
+}
@@ -0,0 +1,10 @@
+# Exclude the sandbox subscriptions from the IPAM discovery
+resource "azureipam_exclusions" "all" {
+  subscription_ids = [
+    "6ba4c0d7-1e2f-4a3b-9c4d-5e6f7a8b9c0d",
+    "0f1e2d3c-4b5a-4978-8695-a4b3c2d1e0f9",
+  ]
+}
+output "exclusions" {
+  value = azureipam_exclusions.all
+}
@@ -0,0 +1,33 @@
+# Create a CIDR reservation
+resource "azureipam_reservation" "new" {
+  space       = "au"
+  blocks      = ["AustraliaEast"]
+  size        = 25
+  description = "Reservation settlement test"
+}
+
+# Deploy the vnet with the reservation tags
+resource "azurerm_resource_group" "test" {
+  name     = "rg-azureipam-settlement-test"
+  location = "Australia East"
+}
+resource "azurerm_virtual_network" "test" {
+  name                = "vnet-azureipam-settlement-test"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  address_space = [azureipam_reservation.new.cidr]
+  tags          = azureipam_reservation.new.tags
+}
+
+# Wait for the reservation settlement
+resource "azureipam_reservation_settlement" "new" {
+  space = azureipam_reservation.new.space
+  block = azureipam_reservation.new.block
+  id    = azureipam_reservation.new.id
+
+  depends_on = [azurerm_virtual_network.test]
+}
+output "settlement" {
+  value = azureipam_reservation_settlement.new
+}
@@ -0,0 +1,6 @@
+# Return the status and version of the IPAM engine
+data "azureipam_status" "engine" {
+}
+output "engine_version" {
+  value = data.azureipam_status.engine.version
+}
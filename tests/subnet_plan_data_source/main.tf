@@ -0,0 +1,12 @@
+# Splits a range into the subnets of a virtual network
+data "azureipam_subnet_plan" "example" {
+  cidr = "10.82.4.0/22"
+  subnets = [
+    { name = "GatewaySubnet", prefix_length = 27 },
+    { name = "app", prefix_length = 24 },
+    { name = "data", prefix_length = 26 },
+  ]
+}
+output "subnet_plan" {
+  value = data.azureipam_subnet_plan.example
+}